@@ -0,0 +1,32 @@
+/*
+Package "backend" defines the interface of compute engines running the kernels of dense matrices.
+The pure Go engine "dense.GoEngine" is used by default,
+and other engines, such as the ones on GPUs, can be plugged in with "dense.SetEngine".
+The kernels work on row-major slices of float64,
+where the element at (i, j) of "x" with the stride "ldx" is x[i*ldx+j].
+*/
+package backend
+
+/*
+"Engine" is the interface for implementations of the kernels of dense matrices.
+*/
+type Engine interface {
+	// Return the name of the engine.
+	Name() string
+
+	// Compute "c" = op("a") op("b") for op("a") of "rows" x "inner" and op("b") of "inner" x "columns",
+	// where op(x) is the transpose of "x" when the corresponding flag is true and "x" itself otherwise.
+	// "c" is initialized with zeros.
+	Multiply(transA, transB bool, rows, inner, columns int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int)
+
+	// Add "alpha" times "x" to "y" element by element, where "y" is not shorter than "x".
+	Add(alpha float64, x, y []float64)
+
+	// Multiply every element of "x" by "alpha".
+	Scale(alpha float64, x []float64)
+
+	// Solve "a" X = "b" for "a" of "size" x "size" and "b" of "size" x "columns",
+	// overwriting "b" with X. The elements of "a" may be overwritten.
+	// When "a" is singular, an error is returned.
+	Solve(size, columns int, a []float64, lda int, b []float64, ldb int) error
+}
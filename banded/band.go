@@ -0,0 +1,170 @@
+/*
+Package "banded" provides storage formats for matrices whose non-zero elements
+are concentrated around the diagonal.
+*/
+package banded
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/shape"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+/*
+"Band" stores the elements of a matrix within the lower and upper bandwidth.
+The row "i" holds the columns from "i - lower" to "i + upper".
+*/
+type Band struct {
+	shape    *shape.Shape
+	lower    int
+	upper    int
+	elements []float64
+}
+
+// Create a new zero band matrix with the given bandwidth.
+// When "rows" and "columns" is not positive,
+// validates.NON_POSITIVE_SIZE_PANIC will be caused,
+// when "lower" or "upper" is negative,
+// validates.NEGATIVE_BANDWIDTH_PANIC will be caused,
+// and when the size of the band overflows int,
+// validates.SIZE_OVERFLOW_PANIC will be caused.
+func NewBand(rows, columns, lower, upper int) *Band {
+	validates.ShapeShouldBePositive(rows, columns)
+	validates.BandwidthShouldNotBeNegative(lower, upper)
+	validates.ShapeShouldNotOverflow(rows, lower+upper+1)
+
+	b := &Band{
+		shape:    shape.NewShape(rows, columns),
+		lower:    lower,
+		upper:    upper,
+		elements: make([]float64, rows*(lower+upper+1)),
+	}
+
+	return b
+}
+
+// Convert the given matrix to *Band.
+// The bandwidth is detected from the non-zero elements of "m",
+// so elements are visited only through (Matrix).NonZeros.
+func ToBand(m types.Matrix) *Band {
+	lower, upper := Bandwidth(m)
+
+	b := NewBand(m.Rows(), m.Columns(), lower, upper)
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		b.Update(row, column, element)
+	}
+
+	return b
+}
+
+// Return the lower and upper bandwidth of "m",
+// which are the largest distances of non-zero elements below and above the diagonal.
+func Bandwidth(m types.Matrix) (lower, upper int) {
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		_, row, column := cursor.Get()
+
+		if d := row - column; d > lower {
+			lower = d
+		}
+
+		if d := column - row; d > upper {
+			upper = d
+		}
+	}
+
+	return lower, upper
+}
+
+func (b *Band) Shape() (rows, columns int) {
+	return b.shape.Rows(), b.shape.Columns()
+}
+
+func (b *Band) Rows() (rows int) {
+	return b.shape.Rows()
+}
+
+func (b *Band) Columns() (columns int) {
+	return b.shape.Columns()
+}
+
+// Return the lower and upper bandwidth.
+func (b *Band) Bandwidth() (lower, upper int) {
+	return b.lower, b.upper
+}
+
+// Check whether the element at "row" and "column" is stored or not.
+func (b *Band) InBand(row, column int) bool {
+	d := column - row
+	return -b.lower <= d && d <= b.upper
+}
+
+// Get an element of matrix specified with "row" and "column".
+// Elements out of the band are zero.
+// When "row" or "column" is out of the shape,
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func (b *Band) Get(row, column int) (element float64) {
+	validates.IndexShouldBeInRange(b.Rows(), b.Columns(), row, column)
+
+	if !b.InBand(row, column) {
+		return 0
+	}
+
+	return b.elements[b.index(row, column)]
+}
+
+// Update the element of matrix specified with "row" and "column".
+// When "row" or "column" is out of the shape or the band,
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func (b *Band) Update(row, column int, element float64) *Band {
+	validates.IndexShouldBeInRange(b.Rows(), b.Columns(), row, column)
+
+	if !b.InBand(row, column) {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+
+	b.elements[b.index(row, column)] = element
+
+	return b
+}
+
+// Create a new dense matrix which has the same elements.
+func (b *Band) Dense() *dense.Matrix {
+	m := dense.Zeros(b.Rows(), b.Columns())
+
+	for row := 0; row < b.Rows(); row++ {
+		for column := b.first(row); column < b.last(row); column++ {
+			m.Update(row, column, b.Get(row, column))
+		}
+	}
+
+	return m
+}
+
+func (b *Band) index(row, column int) int {
+	return row*(b.lower+b.upper+1) + column - row + b.lower
+}
+
+// Return the first column stored in "row".
+func (b *Band) first(row int) int {
+	if column := row - b.lower; column > 0 {
+		return column
+	}
+
+	return 0
+}
+
+// Return the column next to the last one stored in "row".
+func (b *Band) last(row int) int {
+	if column := row + b.upper + 1; column < b.Columns() {
+		return column
+	}
+
+	return b.Columns()
+}
@@ -0,0 +1,126 @@
+package banded
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestBandwidthDetectsLowerAndUpper(t *testing.T) {
+	m := dense.New(4, 4)(
+		1, 2, 0, 0,
+		3, 4, 5, 0,
+		6, 7, 8, 9,
+		0, 1, 2, 3,
+	)
+
+	if lower, upper := Bandwidth(m); lower != 2 || upper != 1 {
+		t.Fatalf("The bandwidth should be (2, 1), but is (%d, %d).", lower, upper)
+	}
+}
+
+func TestToBandKeepsElements(t *testing.T) {
+	m := dense.New(4, 5)(
+		1, 2, 0, 0, 0,
+		3, 4, 5, 0, 0,
+		0, 7, 8, 9, 0,
+		0, 0, 2, 3, 4,
+	)
+
+	b := ToBand(m)
+
+	if lower, upper := b.Bandwidth(); lower != 1 || upper != 1 {
+		t.Fatalf("The bandwidth should be (1, 1), but is (%d, %d).", lower, upper)
+	}
+
+	if !b.Dense().Equal(m) {
+		t.Fatal("The band matrix should have the same elements as the original.")
+	}
+}
+
+func TestBandGetReturnsZeroOutOfBand(t *testing.T) {
+	b := NewBand(3, 3, 0, 0)
+
+	if b.Get(2, 0) == 0 && b.Get(0, 2) == 0 {
+		return
+	}
+
+	t.Fatal("The elements out of the band should be zero.")
+}
+
+func TestBandUpdateCausesPanicOutOfBand(t *testing.T) {
+	b := NewBand(3, 3, 1, 0)
+
+	defer func() {
+		if p := recover(); p == validates.OUT_OF_RANGE_PANIC {
+			return
+		}
+
+		t.Fatalf("Updating out of the band should cause %s.", validates.OUT_OF_RANGE_PANIC)
+	}()
+	b.Update(0, 1, 1)
+}
+
+func TestNewBandCausesPanicForNegativeBandwidth(t *testing.T) {
+	for _, bandwidth := range [][2]int{{-1, 0}, {0, -2}} {
+		func() {
+			defer func() {
+				if p := recover(); p == validates.NEGATIVE_BANDWIDTH_PANIC {
+					return
+				}
+
+				t.Fatalf("The bandwidth %v should cause %s.", bandwidth, validates.NEGATIVE_BANDWIDTH_PANIC)
+			}()
+			NewBand(3, 3, bandwidth[0], bandwidth[1])
+		}()
+	}
+}
+
+func TestToSkylineKeepsElements(t *testing.T) {
+	m := dense.New(5, 5)(
+		1, 0, 2, 0, 0,
+		0, 3, 4, 0, 0,
+		0, 5, 6, 0, 7,
+		8, 0, 0, 9, 1,
+		0, 0, 0, 2, 3,
+	)
+
+	s := ToSkyline(m)
+
+	// The lower profile of rows: 1, 1, 2, 4, 2, and the upper one of columns: 0, 0, 2, 0, 2.
+	if envelope := s.Envelope(); envelope != 14 {
+		t.Fatalf("The envelope should be %d, but is %d.", 14, envelope)
+	}
+
+	if !s.Dense().Equal(m) {
+		t.Fatal("The skyline matrix should have the same elements as the original.")
+	}
+}
+
+func TestToSkylineCausesPanicForNonSquare(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.NOT_SQUARE_PANIC {
+			return
+		}
+
+		t.Fatalf("Non-square matrix should cause %s.", validates.NOT_SQUARE_PANIC)
+	}()
+	ToSkyline(dense.Zeros(2, 3))
+}
+
+func TestSkylineUpdateCausesPanicOutOfProfile(t *testing.T) {
+	s := ToSkyline(dense.New(2, 2)(
+		1, 0,
+		0, 1,
+	))
+
+	defer func() {
+		if p := recover(); p == validates.OUT_OF_RANGE_PANIC {
+			return
+		}
+
+		t.Fatalf("Updating out of the profile should cause %s.", validates.OUT_OF_RANGE_PANIC)
+	}()
+	s.Update(1, 0, 1)
+}
@@ -0,0 +1,104 @@
+package banded
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	NotPositiveDefiniteError = "NotPositiveDefiniteError"
+)
+
+/*
+"CholeskyResult" is the Cholesky decomposition A = L * L^T of a symmetric positive-definite band matrix,
+where "L" is lower triangular and has the same lower bandwidth as A.
+*/
+type CholeskyResult struct {
+	l *Band
+}
+
+// Compute the Cholesky decomposition of the symmetric positive-definite band matrix "a"
+// in O(n * lower^2) time, keeping the factor banded.
+// Only the lower band of "a" is read.
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "a" is not positive-definite, NotPositiveDefiniteError is returned.
+func Cholesky(a *Band) (*CholeskyResult, error) {
+	validates.ShapeShouldBeSquare(a)
+
+	n := a.Rows()
+	p, _ := a.Bandwidth()
+
+	l := NewBand(n, n, p, 0)
+
+	for j := 0; j < n; j++ {
+		s := a.Get(j, j)
+		for k := l.first(j); k < j; k++ {
+			s -= l.Get(j, k) * l.Get(j, k)
+		}
+
+		if s <= 0 {
+			return nil, errors.New(NotPositiveDefiniteError)
+		}
+
+		d := math.Sqrt(s)
+		l.Update(j, j, d)
+
+		for i := j + 1; i < n && i <= j+p; i++ {
+			s := a.Get(i, j)
+			for k := l.first(i); k < j; k++ {
+				s -= l.Get(i, k) * l.Get(j, k)
+			}
+
+			l.Update(i, j, s/d)
+		}
+	}
+
+	return &CholeskyResult{l: l}, nil
+}
+
+// Return the lower triangular factor.
+func (c *CholeskyResult) L() *Band {
+	return c.l
+}
+
+// Solve A * X = B by the forward and backward substitution within the band.
+// When the rows of "b" don't equal to the ones of A,
+// validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func (c *CholeskyResult) Solve(b types.Matrix) *dense.Matrix {
+	validates.ShapeShouldBeMultipliable(c.l, b)
+
+	n := c.l.Rows()
+	p, _ := c.l.Bandwidth()
+
+	x := dense.Zeros(b.Rows(), b.Columns())
+	x.Add(b)
+
+	// The indexes are within the shape of "x" validated above.
+	for column := 0; column < b.Columns(); column++ {
+		// Solve L * Y = B.
+		for i := 0; i < n; i++ {
+			s := x.GetUnchecked(i, column)
+			for k := c.l.first(i); k < i; k++ {
+				s -= c.l.Get(i, k) * x.GetUnchecked(k, column)
+			}
+
+			x.UpdateUnchecked(i, column, s/c.l.Get(i, i))
+		}
+
+		// Solve L^T * X = Y.
+		for i := n - 1; i >= 0; i-- {
+			s := x.GetUnchecked(i, column)
+			for k := i + 1; k < n && k <= i+p; k++ {
+				s -= c.l.Get(k, i) * x.GetUnchecked(k, column)
+			}
+
+			x.UpdateUnchecked(i, column, s/c.l.Get(i, i))
+		}
+	}
+
+	return x
+}
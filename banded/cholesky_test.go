@@ -0,0 +1,78 @@
+package banded
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestCholeskyFactorizesBandMatrix(t *testing.T) {
+	a := dense.New(4, 4)(
+		4, 2, 0, 0,
+		2, 5, 2, 0,
+		0, 2, 5, 2,
+		0, 0, 2, 5,
+	)
+
+	c, err := Cholesky(ToBand(a))
+	if err != nil {
+		t.Fatalf("An expected error occured on decomposition: %s", err)
+	}
+
+	if lower, upper := c.L().Bandwidth(); lower != 1 || upper != 0 {
+		t.Fatalf("The factor should keep the bandwidth (1, 0), but has (%d, %d).", lower, upper)
+	}
+
+	l := c.L().Dense()
+
+	r := dense.New(4, 4)(
+		2, 0, 0, 0,
+		1, 2, 0, 0,
+		0, 1, 2, 0,
+		0, 0, 1, 2,
+	)
+
+	if !l.Equal(r) {
+		t.Fatal("The factor is wrong.")
+	}
+}
+
+func TestCholeskySolve(t *testing.T) {
+	a := dense.New(4, 4)(
+		4, 2, 0, 0,
+		2, 5, 2, 0,
+		0, 2, 5, 2,
+		0, 0, 2, 5,
+	)
+
+	x := dense.New(4, 2)(
+		1, 0,
+		-2, 1,
+		0.5, 2,
+		3, -1,
+	)
+
+	c, err := Cholesky(ToBand(a))
+	if err != nil {
+		t.Fatalf("An expected error occured on decomposition: %s", err)
+	}
+
+	if c.Solve(a.Multiply(x)).EqualApprox(x, 1e-12) {
+		return
+	}
+
+	t.Fatal("Solve should return the solution of the system.")
+}
+
+func TestCholeskyFailsForNonPositiveDefinite(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 2,
+		2, 1,
+	)
+
+	if _, err := Cholesky(ToBand(a)); err != nil && err.Error() == NotPositiveDefiniteError {
+		return
+	}
+
+	t.Fatalf("The decomposition of a non-positive-definite matrix should cause %s.", NotPositiveDefiniteError)
+}
@@ -0,0 +1,158 @@
+package banded
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+/*
+"Skyline" stores the elements of a square matrix in the variable band (profile) format.
+The lower part holds the row "i" from its first non-zero column to the diagonal,
+and the upper part holds the column "j" from its first non-zero row to just above the diagonal.
+*/
+type Skyline struct {
+	size  int
+	lower [][]float64
+	upper [][]float64
+}
+
+// Convert the given square matrix to *Skyline.
+// The profile is detected from the non-zero elements of "m",
+// so elements are visited only through (Matrix).NonZeros.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func ToSkyline(m types.Matrix) *Skyline {
+	validates.ShapeShouldBeSquare(m)
+
+	size := m.Rows()
+
+	// The first row or column of the profile, which is initialized with the diagonal.
+	rowStarts := make([]int, size)
+	columnStarts := make([]int, size)
+	for i := 0; i < size; i++ {
+		rowStarts[i] = i
+		columnStarts[i] = i
+	}
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		_, row, column := cursor.Get()
+
+		if column < rowStarts[row] {
+			rowStarts[row] = column
+		}
+
+		if row < columnStarts[column] {
+			columnStarts[column] = row
+		}
+	}
+
+	s := &Skyline{
+		size:  size,
+		lower: make([][]float64, size),
+		upper: make([][]float64, size),
+	}
+
+	for i := 0; i < size; i++ {
+		s.lower[i] = make([]float64, i-rowStarts[i]+1)
+		s.upper[i] = make([]float64, i-columnStarts[i])
+	}
+
+	cursor = m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		s.Update(row, column, element)
+	}
+
+	return s
+}
+
+func (s *Skyline) Shape() (rows, columns int) {
+	return s.size, s.size
+}
+
+func (s *Skyline) Rows() (rows int) {
+	return s.size
+}
+
+func (s *Skyline) Columns() (columns int) {
+	return s.size
+}
+
+// Return the number of stored elements.
+func (s *Skyline) Envelope() int {
+	envelope := 0
+
+	for i := 0; i < s.size; i++ {
+		envelope += len(s.lower[i]) + len(s.upper[i])
+	}
+
+	return envelope
+}
+
+// Check whether the element at "row" and "column" is stored or not.
+func (s *Skyline) InProfile(row, column int) bool {
+	if column <= row {
+		return row-column < len(s.lower[row])
+	}
+
+	return column-row <= len(s.upper[column])
+}
+
+// Get an element of matrix specified with "row" and "column".
+// Elements out of the profile are zero.
+// When "row" or "column" is out of the shape,
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func (s *Skyline) Get(row, column int) (element float64) {
+	validates.IndexShouldBeInRange(s.size, s.size, row, column)
+
+	if !s.InProfile(row, column) {
+		return 0
+	}
+
+	if column <= row {
+		return s.lower[row][len(s.lower[row])-1-(row-column)]
+	}
+
+	return s.upper[column][len(s.upper[column])-(column-row)]
+}
+
+// Update the element of matrix specified with "row" and "column".
+// When "row" or "column" is out of the shape or the profile,
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func (s *Skyline) Update(row, column int, element float64) *Skyline {
+	validates.IndexShouldBeInRange(s.size, s.size, row, column)
+
+	if !s.InProfile(row, column) {
+		panic(validates.OUT_OF_RANGE_PANIC)
+	}
+
+	if column <= row {
+		s.lower[row][len(s.lower[row])-1-(row-column)] = element
+	} else {
+		s.upper[column][len(s.upper[column])-(column-row)] = element
+	}
+
+	return s
+}
+
+// Create a new dense matrix which has the same elements.
+func (s *Skyline) Dense() *dense.Matrix {
+	m := dense.Zeros(s.size, s.size)
+
+	for i := 0; i < s.size; i++ {
+		for k := range s.lower[i] {
+			column := i - len(s.lower[i]) + 1 + k
+			m.Update(i, column, s.lower[i][k])
+		}
+
+		for k := range s.upper[i] {
+			row := i - len(s.upper[i]) + k
+			m.Update(row, i, s.upper[i][k])
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,29 @@
+package matrix
+
+import (
+	"github.com/mitsuse/matrix-go/internal/parallel"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Multiply the corresponding matrices of "as" and "bs" in parallel, and return the products.
+// For matrices of the same shape stored contiguously, "dense.MultiplyBatch" is faster.
+// When the lengths of "as" and "bs" differ, validates.DIFFERENT_SIZE_PANIC will be caused,
+// and when a pair is not multipliable, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func MultiplyBatch(as, bs []Matrix) []Matrix {
+	if len(as) != len(bs) {
+		panic(validates.DIFFERENT_SIZE_PANIC)
+	}
+
+	// The shapes are validated in the caller so that the panic can be recovered.
+	for index := range as {
+		validates.ShapeShouldBeMultipliable(as[index], bs[index])
+	}
+
+	products := make([]Matrix, len(as))
+
+	parallel.For(len(as), func(index int) {
+		products[index] = as[index].Multiply(bs[index])
+	})
+
+	return products
+}
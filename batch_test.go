@@ -0,0 +1,48 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestMultiplyBatchMutableDense(t *testing.T) {
+	as := []Matrix{
+		dense.New(2, 2)(1, 2, 3, 4),
+		dense.New(1, 3)(1, 0, -1),
+		dense.New(2, 1)(2, 3),
+	}
+
+	bs := []Matrix{
+		dense.New(2, 2)(0, 1, 1, 0),
+		dense.New(3, 1)(1, 2, 3),
+		dense.New(1, 2)(1, -1),
+	}
+
+	rs := []Matrix{
+		dense.New(2, 2)(2, 1, 4, 3),
+		dense.New(1, 1)(-2),
+		dense.New(2, 2)(2, -2, 3, -3),
+	}
+
+	products := MultiplyBatch(as, bs)
+
+	for index, r := range rs {
+		if !products[index].Equal(r) {
+			t.Fatalf("The product at %d is wrong.", index)
+		}
+	}
+}
+
+func TestMultiplyBatchCausesPanicForNotMultipliable(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.NOT_MULTIPLIABLE_PANIC {
+			return
+		}
+
+		t.Fatal("The pair which is not multipliable should cause a panic.")
+	}()
+
+	MultiplyBatch([]Matrix{dense.Zeros(2, 2)}, []Matrix{dense.Zeros(3, 2)})
+}
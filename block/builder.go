@@ -0,0 +1,151 @@
+/*
+Package "block" provides a builder which composes a matrix from named regions.
+*/
+package block
+
+import (
+	"fmt"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/shape"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+/*
+"OverlapError" is returned by (*Builder).Build
+when two regions cover the same element.
+*/
+type OverlapError struct {
+	Region string
+	Other  string
+	Row    int
+	Column int
+}
+
+func (e *OverlapError) Error() string {
+	return fmt.Sprintf(
+		"region %q overlaps region %q at (%d, %d)",
+		e.Region,
+		e.Other,
+		e.Row,
+		e.Column,
+	)
+}
+
+/*
+"Builder" collects named regions and composes them into a new dense matrix.
+Elements not covered by any region are zero.
+*/
+type Builder struct {
+	shape   *shape.Shape
+	regions []*region
+}
+
+type region struct {
+	name     string
+	contains func(row, column int) bool
+	element  func(row, column int) float64
+}
+
+// Create a new builder for a "rows x columns" matrix.
+// When "rows" and "columns" is not positive,
+// validates.NON_POSITIVE_SIZE_PANIC will be caused,
+// and when the size of elements overflows int,
+// validates.SIZE_OVERFLOW_PANIC will be caused.
+func NewBuilder(rows, columns int) *Builder {
+	validates.ShapeShouldBePositive(rows, columns)
+	validates.ShapeShouldNotOverflow(rows, columns)
+
+	b := &Builder{
+		shape:   shape.NewShape(rows, columns),
+		regions: []*region{},
+	}
+
+	return b
+}
+
+// Place the matrix "m" with its top-left element at "row" and "column".
+// When "m" extends outside the builder,
+// validates.INVALID_VIEW_PANIC will be caused.
+func (b *Builder) Block(name string, row, column int, m types.Matrix) *Builder {
+	rows, columns := m.Shape()
+
+	validates.ViewShouldBeInBase(b.shape, shape.NewShape(rows, columns), shape.NewIndex(row, column))
+
+	r := &region{
+		name: name,
+		contains: func(i, j int) bool {
+			return row <= i && i < row+rows && column <= j && j < column+columns
+		},
+		element: func(i, j int) float64 {
+			return m.Get(i-row, j-column)
+		},
+	}
+	b.regions = append(b.regions, r)
+
+	return b
+}
+
+// Fill the outermost "width" rows and columns with "value".
+func (b *Builder) Border(name string, width int, value float64) *Builder {
+	rows, columns := b.shape.Rows(), b.shape.Columns()
+
+	r := &region{
+		name: name,
+		contains: func(i, j int) bool {
+			return i < width || i >= rows-width || j < width || j >= columns-width
+		},
+		element: func(i, j int) float64 {
+			return value
+		},
+	}
+	b.regions = append(b.regions, r)
+
+	return b
+}
+
+// Fill the band from the "lower"-th diagonal below the main diagonal
+// to the "upper"-th diagonal above it with "value".
+func (b *Builder) Band(name string, lower, upper int, value float64) *Builder {
+	r := &region{
+		name: name,
+		contains: func(i, j int) bool {
+			return -lower <= j-i && j-i <= upper
+		},
+		element: func(i, j int) float64 {
+			return value
+		},
+	}
+	b.regions = append(b.regions, r)
+
+	return b
+}
+
+// Compose the regions into a new dense matrix.
+// When two regions cover the same element, *OverlapError is returned.
+func (b *Builder) Build() (*dense.Matrix, error) {
+	rows, columns := b.shape.Rows(), b.shape.Columns()
+
+	m := dense.Zeros(rows, columns)
+	owners := make([]*region, rows*columns)
+
+	for _, r := range b.regions {
+		for i := 0; i < rows; i++ {
+			for j := 0; j < columns; j++ {
+				if !r.contains(i, j) {
+					continue
+				}
+
+				if owner := owners[i*columns+j]; owner != nil {
+					return nil, &OverlapError{Region: r.name, Other: owner.name, Row: i, Column: j}
+				}
+
+				owners[i*columns+j] = r
+				m.Update(i, j, r.element(i, j))
+			}
+		}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,95 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestBuildComposesBlocks(t *testing.T) {
+	h := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	a := dense.New(1, 2)(5, 6)
+
+	m, err := NewBuilder(3, 3).
+		Block("H", 0, 0, h).
+		Block("A", 2, 0, a).
+		Block("At", 0, 2, a.Transpose()).
+		Build()
+
+	if err != nil {
+		t.Fatalf("An expected error occured on building: %s", err)
+	}
+
+	r := dense.New(3, 3)(
+		1, 2, 5,
+		3, 4, 6,
+		5, 6, 0,
+	)
+
+	if !m.Equal(r) {
+		t.Fatal("The blocks should be placed at the specified offsets.")
+	}
+}
+
+func TestBuildComposesBorderAndBand(t *testing.T) {
+	m, err := NewBuilder(4, 4).
+		Border("border", 1, 9).
+		Band("band", 0, 0, 1).
+		Build()
+
+	if err == nil {
+		t.Fatal("The border should overlap the diagonal band at the corners.")
+	}
+
+	m, err = NewBuilder(4, 4).
+		Border("border", 1, 9).
+		Block("inner", 1, 1, dense.New(2, 2)(1, 2, 3, 4)).
+		Build()
+
+	if err != nil {
+		t.Fatalf("An expected error occured on building: %s", err)
+	}
+
+	r := dense.New(4, 4)(
+		9, 9, 9, 9,
+		9, 1, 2, 9,
+		9, 3, 4, 9,
+		9, 9, 9, 9,
+	)
+
+	if !m.Equal(r) {
+		t.Fatal("The border should surround the inner block.")
+	}
+}
+
+func TestBuildFailsForOverlappingRegions(t *testing.T) {
+	_, err := NewBuilder(3, 3).
+		Block("first", 0, 0, dense.Zeros(2, 2)).
+		Band("second", 0, 1, 1).
+		Build()
+
+	e, isOverlap := err.(*OverlapError)
+	if !isOverlap {
+		t.Fatal("Overlapping regions should cause *OverlapError.")
+	}
+
+	if e.Region != "second" || e.Other != "first" || e.Row != 0 || e.Column != 0 {
+		t.Fatalf("The overlap is reported wrongly: %s", e)
+	}
+}
+
+func TestBlockCausesPanicOutOfBuilder(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.INVALID_VIEW_PANIC {
+			return
+		}
+
+		t.Fatalf("The block extended outside should cause %s.", validates.INVALID_VIEW_PANIC)
+	}()
+	NewBuilder(3, 3).Block("outside", 2, 2, dense.Zeros(2, 2))
+}
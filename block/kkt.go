@@ -0,0 +1,32 @@
+package block
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Assemble the saddle-point (KKT) matrix [[H, A^T], [A, 0]]
+// from the "n x n" matrix "h" and the "m x n" constraint matrix "a".
+// When "h" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when the columns of "a" don't equal to the ones of "h",
+// validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func KKT(h, a types.Matrix) *dense.Matrix {
+	validates.ShapeShouldBeSquare(h)
+	validates.ShapeShouldBeMultipliable(a, h)
+
+	n, m := h.Rows(), a.Rows()
+
+	k, err := NewBuilder(n+m, n+m).
+		Block("H", 0, 0, h).
+		Block("A^T", 0, n, a.Transpose()).
+		Block("A", n, 0, a).
+		Build()
+
+	// The blocks are disjoint by construction.
+	if err != nil {
+		panic(err)
+	}
+
+	return k
+}
@@ -0,0 +1,51 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestKKTAssemblesSaddlePointMatrix(t *testing.T) {
+	h := dense.New(2, 2)(
+		2, 1,
+		1, 3,
+	)
+
+	a := dense.New(1, 2)(1, -1)
+
+	r := dense.New(3, 3)(
+		2, 1, 1,
+		1, 3, -1,
+		1, -1, 0,
+	)
+
+	if KKT(h, a).Equal(r) {
+		return
+	}
+
+	t.Fatal("KKT should assemble [[H, A^T], [A, 0]].")
+}
+
+func TestKKTCausesPanicForNonSquareH(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.NOT_SQUARE_PANIC {
+			return
+		}
+
+		t.Fatalf("Non-square H should cause %s.", validates.NOT_SQUARE_PANIC)
+	}()
+	KKT(dense.Zeros(2, 3), dense.Zeros(1, 3))
+}
+
+func TestKKTCausesPanicForIncompatibleA(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.NOT_MULTIPLIABLE_PANIC {
+			return
+		}
+
+		t.Fatalf("A which has different columns should cause %s.", validates.NOT_MULTIPLIABLE_PANIC)
+	}()
+	KKT(dense.Zeros(2, 2), dense.Zeros(1, 3))
+}
@@ -0,0 +1,119 @@
+/*
+Package "boundary" provides views of matrix which accept indexes out of its shape
+by mapping them back according to a boundary condition.
+Views refer to the elements of the base matrix without copying them.
+*/
+package boundary
+
+import (
+	"github.com/mitsuse/matrix-go/types"
+)
+
+/*
+mapFunc is a type of functions which map an arbitrary index to the range [0, n).
+*/
+type mapFunc func(index, n int) int
+
+/*
+"View" reads and writes the elements of the base matrix
+with indexes mapped by a boundary condition.
+*/
+type View struct {
+	base     types.Matrix
+	mapIndex mapFunc
+}
+
+// Create a view with the periodic boundary condition,
+// where indexes are taken modulo the shape of "m".
+func Periodic(m types.Matrix) *View {
+	v := &View{
+		base:     m,
+		mapIndex: wrap,
+	}
+
+	return v
+}
+
+// Create a view with the reflective boundary condition,
+// where indexes are mirrored at the edges without repeating the edge elements.
+// For example, the row -1 is mapped to 1 and the row "rows" is mapped to "rows - 2".
+func Reflect(m types.Matrix) *View {
+	v := &View{
+		base:     m,
+		mapIndex: reflect,
+	}
+
+	return v
+}
+
+// Create a view with the symmetric boundary condition,
+// where indexes are mirrored at the edges repeating the edge elements.
+// For example, the row -1 is mapped to 0 and the row "rows" is mapped to "rows - 1".
+func Symmetric(m types.Matrix) *View {
+	v := &View{
+		base:     m,
+		mapIndex: symmetric,
+	}
+
+	return v
+}
+
+// Return the base matrix.
+func (v *View) Base() types.Matrix {
+	return v.base
+}
+
+// Return the shape of the base matrix.
+func (v *View) Shape() (rows, columns int) {
+	return v.base.Shape()
+}
+
+func (v *View) Rows() (rows int) {
+	return v.base.Rows()
+}
+
+func (v *View) Columns() (columns int) {
+	return v.base.Columns()
+}
+
+// Get the element of the base matrix at the index mapped from "row" and "column".
+func (v *View) Get(row, column int) (element float64) {
+	return v.base.Get(v.mapIndex(row, v.Rows()), v.mapIndex(column, v.Columns()))
+}
+
+// Update the element of the base matrix at the index mapped from "row" and "column".
+func (v *View) Update(row, column int, element float64) *View {
+	v.base.Update(v.mapIndex(row, v.Rows()), v.mapIndex(column, v.Columns()), element)
+
+	return v
+}
+
+func wrap(index, n int) int {
+	if index %= n; index < 0 {
+		return index + n
+	}
+
+	return index
+}
+
+func reflect(index, n int) int {
+	if n == 1 {
+		return 0
+	}
+
+	index = wrap(index, 2*(n-1))
+	if index < n {
+		return index
+	}
+
+	return 2*(n-1) - index
+}
+
+func symmetric(index, n int) int {
+	index = wrap(index, 2*n)
+	if index < n {
+		return index
+	}
+
+	return 2*n - 1 - index
+}
@@ -0,0 +1,92 @@
+package boundary
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+type boundaryTest struct {
+	row     int
+	column  int
+	element float64
+}
+
+func TestPeriodicWrapsIndexes(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	)
+
+	tests := []*boundaryTest{
+		&boundaryTest{row: 0, column: 0, element: 0},
+		&boundaryTest{row: 1, column: 3, element: 3},
+		&boundaryTest{row: -1, column: -1, element: 5},
+		&boundaryTest{row: 4, column: -4, element: 2},
+		&boundaryTest{row: -3, column: 7, element: 4},
+	}
+
+	v := Periodic(m)
+
+	for _, test := range tests {
+		if element := v.Get(test.row, test.column); element != test.element {
+			t.Fatalf(
+				"The element at (%d, %d) should be %v, but is %v.",
+				test.row,
+				test.column,
+				test.element,
+				element,
+			)
+		}
+	}
+}
+
+func TestPeriodicUpdatesTheBase(t *testing.T) {
+	m := dense.Zeros(2, 2)
+
+	Periodic(m).Update(-1, 2, 7)
+
+	if m.Get(1, 0) == 7 {
+		return
+	}
+
+	t.Fatal("Updating a periodic view should rewrite the wrapped element of the base.")
+}
+
+func TestReflectMirrorsIndexesWithoutEdges(t *testing.T) {
+	m := dense.New(1, 3)(0, 1, 2)
+
+	elements := map[int]float64{-4: 0, -3: 1, -2: 2, -1: 1, 0: 0, 2: 2, 3: 1, 4: 0, 5: 1, 6: 2}
+
+	v := Reflect(m)
+
+	for column, element := range elements {
+		if e := v.Get(0, column); e != element {
+			t.Fatalf("The element at (0, %d) should be %v, but is %v.", column, element, e)
+		}
+	}
+}
+
+func TestReflectOfSingleRow(t *testing.T) {
+	m := dense.New(1, 2)(3, 4)
+
+	if Reflect(m).Get(-5, 2) == 3 {
+		return
+	}
+
+	t.Fatal("Any row should be mapped to the only row.")
+}
+
+func TestSymmetricMirrorsIndexesWithEdges(t *testing.T) {
+	m := dense.New(3, 1)(0, 1, 2)
+
+	elements := map[int]float64{-4: 2, -3: 2, -2: 1, -1: 0, 0: 0, 2: 2, 3: 2, 4: 1, 5: 0, 6: 0}
+
+	v := Symmetric(m)
+
+	for row, element := range elements {
+		if e := v.Get(row, 0); e != element {
+			t.Fatalf("The element at (%d, 0) should be %v, but is %v.", row, element, e)
+		}
+	}
+}
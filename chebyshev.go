@@ -0,0 +1,97 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Create a new matrix applying the filter "coeffs[0] T_0(s) + ... + coeffs[k] T_k(s)" to "signal",
+// where T_k is the Chebyshev polynomial of the first kind
+// and "s" is "laplacian" scaled as "2 laplacian / lambdaMax - I" to have the spectrum in [-1, 1].
+// "lambdaMax" should be positive and bound the eigenvalues of "laplacian", e.g. 2 for normalized Laplacian.
+// Only products of "laplacian" and the signal are computed, without eigendecomposition.
+// When "coeffs" is empty, zero matrix is returned.
+// When "laplacian" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when the rows of "signal" differ from the columns of "laplacian",
+// validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func ChebyshevFilter(laplacian Matrix, lambdaMax float64, coeffs []float64, signal Matrix) Matrix {
+	validates.ShapeShouldBeSquare(laplacian)
+	validates.ShapeShouldBeMultipliable(laplacian, signal)
+
+	r := dense.Zeros(signal.Rows(), signal.Columns())
+
+	if len(coeffs) == 0 {
+		return r
+	}
+
+	scaled := func(x Matrix) Matrix {
+		return laplacian.Multiply(x).Scalar(2 / lambdaMax).Subtract(x)
+	}
+
+	// "previous" and "current" are T_{k-1}(s) signal and T_k(s) signal.
+	var previous Matrix
+	current := dense.Zeros(signal.Rows(), signal.Columns()).Add(signal)
+
+	addScaled(r, current, coeffs[0])
+
+	for k := 1; k < len(coeffs); k++ {
+		var next Matrix
+
+		if k == 1 {
+			next = scaled(current)
+		} else {
+			next = scaled(current).Scalar(2).Subtract(previous)
+		}
+
+		previous, current = current, next
+
+		addScaled(r, current, coeffs[k])
+	}
+
+	return r
+}
+
+// Create a new normalized Laplacian "I - D^-1/2 adjacency D^-1/2" of the graph given as "adjacency",
+// where "D" is the diagonal matrix of the row sums of "adjacency".
+// The rows and columns of isolated vertices and vertices of non-positive degrees are zeros.
+// When "adjacency" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func NormalizedLaplacian(adjacency Matrix) Matrix {
+	validates.ShapeShouldBeSquare(adjacency)
+
+	size := adjacency.Rows()
+	scales := make([]float64, size)
+
+	cursor := adjacency.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, _ := cursor.Get()
+		scales[row] += element
+	}
+
+	for i, degree := range scales {
+		if degree > 0 {
+			scales[i] = 1 / math.Sqrt(degree)
+		} else {
+			scales[i] = 0
+		}
+	}
+
+	l := dense.Zeros(size, size)
+
+	for i, scale := range scales {
+		if scale > 0 {
+			l.Update(i, i, 1)
+		}
+	}
+
+	cursor = adjacency.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		l.Update(row, column, l.Get(row, column)-scales[row]*element*scales[column])
+	}
+
+	return l
+}
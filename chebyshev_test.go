@@ -0,0 +1,154 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestChebyshevFilterOfDiagonalMutableDense(t *testing.T) {
+	lambdas := []float64{0, 0.5, 1.25, 2}
+	coeffs := []float64{0.5, -1, 0.25, 2, -0.75}
+
+	laplacian := scaledIdentity(len(lambdas), 0)
+	for i, lambda := range lambdas {
+		laplacian.Update(i, i, lambda)
+	}
+
+	signal := dense.New(4, 2)(
+		1, 2,
+		-1, 0.5,
+		3, 0,
+		0.25, -2,
+	)
+
+	r := dense.Zeros(4, 2)
+
+	for i, lambda := range lambdas {
+		theta := math.Acos(lambda - 1)
+
+		response := 0.0
+		for k, c := range coeffs {
+			response += c * math.Cos(float64(k)*theta)
+		}
+
+		for j := 0; j < 2; j++ {
+			r.Update(i, j, response*signal.Get(i, j))
+		}
+	}
+
+	if ChebyshevFilter(laplacian, 2, coeffs, signal).EqualApprox(r, 1e-12) {
+		return
+	}
+
+	t.Fatal("The filter should scale each eigencomponent by the response.")
+}
+
+func TestChebyshevFilterKeepsArgumentsMutableDense(t *testing.T) {
+	laplacian := NormalizedLaplacian(dense.New(3, 3)(
+		0, 1, 1,
+		1, 0, 0,
+		1, 0, 0,
+	))
+
+	l := dense.Zeros(3, 3).Add(laplacian)
+
+	signal := dense.New(3, 1)(1, 2, 3)
+
+	ChebyshevFilter(laplacian, 2, []float64{1, 2, 3, 4}, signal)
+
+	if laplacian.Equal(l) && signal.Equal(dense.New(3, 1)(1, 2, 3)) {
+		return
+	}
+
+	t.Fatal("The arguments should not be modified by the filter.")
+}
+
+func TestChebyshevFilterOfConstantIsScaleMutableDense(t *testing.T) {
+	laplacian := NormalizedLaplacian(dense.New(2, 2)(
+		0, 1,
+		1, 0,
+	))
+
+	signal := dense.New(2, 1)(1, 2)
+
+	if ChebyshevFilter(laplacian, 2, []float64{3}, signal).Equal(dense.New(2, 1)(3, 6)) {
+		return
+	}
+
+	t.Fatal("The filter of degree 0 should scale the signal.")
+}
+
+func TestChebyshevFilterCausesPanicForNonMultipliableMutableDense(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.NOT_MULTIPLIABLE_PANIC {
+			return
+		}
+
+		t.Fatalf("Non-multipliable signal should cause %s.", validates.NOT_MULTIPLIABLE_PANIC)
+	}()
+
+	ChebyshevFilter(dense.Zeros(2, 2), 2, []float64{1}, dense.Zeros(3, 1))
+}
+
+func TestNormalizedLaplacianMutableDense(t *testing.T) {
+	adjacency := dense.New(3, 3)(
+		0, 1, 0,
+		1, 0, 0,
+		0, 0, 0,
+	)
+
+	r := dense.New(3, 3)(
+		1, -1, 0,
+		-1, 1, 0,
+		0, 0, 0,
+	)
+
+	if NormalizedLaplacian(adjacency).EqualApprox(r, 1e-12) {
+		return
+	}
+
+	t.Fatal("The normalized Laplacian is wrong.")
+}
+
+func TestNormalizedLaplacianOfIsolatedVertexMutableDense(t *testing.T) {
+	adjacency := dense.New(3, 3)(
+		0, 0, 2,
+		0, 0, 0,
+		2, 0, 0,
+	)
+
+	r := dense.New(3, 3)(
+		1, 0, -1,
+		0, 0, 0,
+		-1, 0, 1,
+	)
+
+	if NormalizedLaplacian(adjacency).EqualApprox(r, 1e-12) {
+		return
+	}
+
+	t.Fatal("The row and column of the isolated vertex should be zeros.")
+}
+
+func TestNormalizedLaplacianOfNegativeDegreeMutableDense(t *testing.T) {
+	adjacency := dense.New(3, 3)(
+		0, 1, 0,
+		1, 0, -3,
+		0, -3, 0,
+	)
+
+	r := dense.New(3, 3)(
+		1, 0, 0,
+		0, 0, 0,
+		0, 0, 0,
+	)
+
+	if NormalizedLaplacian(adjacency).EqualApprox(r, 1e-12) {
+		return
+	}
+
+	t.Fatal("The rows and columns of the vertices of negative degrees should be zeros.")
+}
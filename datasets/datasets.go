@@ -0,0 +1,262 @@
+/*
+Package "datasets" downloads, caches and loads standard matrices,
+so that examples, benchmarks and experiments share realistic inputs.
+*/
+package datasets
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/encode/matrixmarket"
+)
+
+const (
+	NotFoundInArchiveError = "NotFoundInArchiveError"
+	InvalidIDXError        = "InvalidIDXError"
+)
+
+/*
+"Dataset" is a matrix which can be downloaded from "URL".
+*/
+type Dataset struct {
+	Name string
+	URL  string
+
+	load func(r io.Reader) (*dense.Matrix, error)
+}
+
+const (
+	suiteSparse = "https://suitesparse-collection-website.herokuapp.com/MM/"
+	mnist       = "https://storage.googleapis.com/cvdf-datasets/mnist/"
+)
+
+var (
+	// The stiffness matrix of 48x48 from the Harwell-Boeing collection.
+	BCSSTK01 = suiteSparseDataset("HB", "bcsstk01")
+
+	// The adjacency matrix of Zachary's karate club network of 34x34.
+	Karate = suiteSparseDataset("Newman", "karate")
+
+	// The unsymmetric matrix of 479x479 from a chemical process simulation in the Harwell-Boeing collection.
+	West0479 = suiteSparseDataset("HB", "west0479")
+
+	// The images of MNIST, each row of which is 784 pixels in [0, 255].
+	MNISTTrainImages = mnistDataset("train-images-idx3-ubyte")
+	MNISTTestImages  = mnistDataset("t10k-images-idx3-ubyte")
+
+	// The labels of MNIST as column vectors.
+	MNISTTrainLabels = mnistDataset("train-labels-idx1-ubyte")
+	MNISTTestLabels  = mnistDataset("t10k-labels-idx1-ubyte")
+)
+
+func suiteSparseDataset(group, name string) *Dataset {
+	d := &Dataset{
+		Name: name,
+		URL:  suiteSparse + group + "/" + name + ".tar.gz",
+		load: func(r io.Reader) (*dense.Matrix, error) {
+			return loadMatrixMarketInTarGz(r, name+"/"+name+".mtx")
+		},
+	}
+
+	return d
+}
+
+func mnistDataset(name string) *Dataset {
+	d := &Dataset{
+		Name: "mnist-" + name,
+		URL:  mnist + name + ".gz",
+		load: loadIDXGz,
+	}
+
+	return d
+}
+
+/*
+"Cache" keeps downloaded datasets in "Directory".
+When "Client" is nil, http.DefaultClient is used.
+*/
+type Cache struct {
+	Directory string
+	Client    *http.Client
+}
+
+// Create a new cache of datasets in "directory".
+// When "directory" is empty, "DefaultDirectory()" is used.
+func NewCache(directory string) *Cache {
+	if directory == "" {
+		directory = DefaultDirectory()
+	}
+
+	c := &Cache{
+		Directory: directory,
+		Client:    http.DefaultClient,
+	}
+
+	return c
+}
+
+// Return "$XDG_CACHE_HOME/matrix-go", or "$HOME/.cache/matrix-go" when XDG_CACHE_HOME is not set.
+func DefaultDirectory() string {
+	if base := os.Getenv("XDG_CACHE_HOME"); base != "" {
+		return filepath.Join(base, "matrix-go")
+	}
+
+	return filepath.Join(os.Getenv("HOME"), ".cache", "matrix-go")
+}
+
+// Return the path of the downloaded file of "d", which is named after the last element of "d.URL".
+func (c *Cache) Path(d *Dataset) string {
+	return filepath.Join(c.Directory, path.Base(d.URL))
+}
+
+// Load "d" as a matrix, downloading it when it is not cached yet.
+func (c *Cache) Load(d *Dataset) (*dense.Matrix, error) {
+	name := c.Path(d)
+
+	if _, err := os.Stat(name); os.IsNotExist(err) {
+		if err := c.download(d.URL, name); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return d.load(file)
+}
+
+// Download "url" to "name" via a temporary file, so that a partial download is never cached.
+func (c *Cache) download(url, name string) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	response, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: %s", url, response.Status)
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(name), filepath.Base(name))
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, response.Body); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+
+		return err
+	}
+
+	return os.Rename(file.Name(), name)
+}
+
+// Load a MatrixMarket file named "name" from gzipped tar archive.
+// When "name" is not found in the archive, NotFoundInArchiveError is returned.
+func loadMatrixMarketInTarGz(r io.Reader, name string) (*dense.Matrix, error) {
+	decompressed, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer decompressed.Close()
+
+	archive := tar.NewReader(decompressed)
+
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil, errors.New(NotFoundInArchiveError)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimPrefix(header.Name, "./") == name {
+			return matrixmarket.Read(archive)
+		}
+	}
+}
+
+// Load a gzipped IDX file of unsigned bytes.
+// The first dimension is the rows, and the others are flattened into the columns.
+// When the file is not IDX of unsigned bytes, InvalidIDXError is returned.
+func loadIDXGz(r io.Reader) (*dense.Matrix, error) {
+	decompressed, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer decompressed.Close()
+
+	var magic [4]byte
+	if _, err := io.ReadFull(decompressed, magic[:]); err != nil {
+		return nil, errors.New(InvalidIDXError)
+	}
+
+	// The magic is two zeros, the type of elements (0x08 for unsigned bytes) and the number of dimensions.
+	if magic[0] != 0 || magic[1] != 0 || magic[2] != 0x08 || magic[3] == 0 {
+		return nil, errors.New(InvalidIDXError)
+	}
+
+	dimensions := make([]uint32, magic[3])
+	if err := binary.Read(decompressed, binary.BigEndian, dimensions); err != nil {
+		return nil, errors.New(InvalidIDXError)
+	}
+
+	// The trailing dimensions are flattened into the columns, checking every product for overflow.
+	rows, columns := int(dimensions[0]), 1
+	for _, dimension := range dimensions[1:] {
+		if err := dense.CheckShape(columns, int(dimension)); err != nil {
+			return nil, err
+		}
+
+		columns *= int(dimension)
+	}
+
+	if err := dense.CheckShape(rows, columns); err != nil {
+		return nil, err
+	}
+
+	pixels := make([]byte, rows*columns)
+	if _, err := io.ReadFull(decompressed, pixels); err != nil {
+		return nil, errors.New(InvalidIDXError)
+	}
+
+	elements := make([]float64, len(pixels))
+	for i, pixel := range pixels {
+		elements[i] = float64(pixel)
+	}
+
+	return dense.New(rows, columns)(elements...), nil
+}
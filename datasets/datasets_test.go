@@ -0,0 +1,196 @@
+package datasets
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// Compress "b" with gzip.
+func gzipped(b []byte) []byte {
+	buffer := &bytes.Buffer{}
+
+	writer := gzip.NewWriter(buffer)
+	writer.Write(b)
+	writer.Close()
+
+	return buffer.Bytes()
+}
+
+// Create gzipped tar archive of "files" keyed by their names.
+func tarGzipped(names []string, files [][]byte) []byte {
+	buffer := &bytes.Buffer{}
+
+	writer := tar.NewWriter(buffer)
+
+	for i, name := range names {
+		writer.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(files[i]))})
+		writer.Write(files[i])
+	}
+
+	writer.Close()
+
+	return gzipped(buffer.Bytes())
+}
+
+// Serve "files" keyed by their paths and count the requests.
+func serve(files map[string][]byte, requests *int) *httptest.Server {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		file, exist := files[r.URL.Path]
+		if !exist {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Write(file)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(handler))
+}
+
+func TestLoadDownloadsAndCaches(t *testing.T) {
+	mtx := []byte("%%MatrixMarket matrix coordinate real symmetric\n2 2 2\n1 1 4\n2 1 -1\n")
+
+	files := map[string][]byte{
+		"/HB/small.tar.gz": tarGzipped(
+			[]string{"small/README", "small/small.mtx"},
+			[][]byte{[]byte("readme"), mtx},
+		),
+	}
+
+	requests := 0
+	server := serve(files, &requests)
+	defer server.Close()
+
+	directory, err := ioutil.TempDir("", "datasets")
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+	defer os.RemoveAll(directory)
+
+	d := suiteSparseDataset("HB", "small")
+	d.URL = server.URL + "/HB/small.tar.gz"
+
+	cache := NewCache(directory)
+
+	r := dense.New(2, 2)(
+		4, -1,
+		-1, 0,
+	)
+
+	for i := 0; i < 2; i++ {
+		m, err := cache.Load(d)
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if !m.Equal(r) {
+			t.Fatal("The loaded matrix is wrong.")
+		}
+	}
+
+	if requests != 1 {
+		t.Fatalf("The dataset should be downloaded once, but is downloaded %d times.", requests)
+	}
+}
+
+func TestLoadFailsForMissingFile(t *testing.T) {
+	requests := 0
+	server := serve(map[string][]byte{}, &requests)
+	defer server.Close()
+
+	directory, err := ioutil.TempDir("", "datasets")
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+	defer os.RemoveAll(directory)
+
+	d := mnistDataset("missing")
+	d.URL = server.URL + "/missing.gz"
+
+	cache := NewCache(directory)
+
+	if _, err := cache.Load(d); err == nil {
+		t.Fatal("Loading the missing dataset should fail.")
+	}
+
+	if _, err := os.Stat(cache.Path(d)); !os.IsNotExist(err) {
+		t.Fatal("The failed download should not be cached.")
+	}
+}
+
+func TestLoadIDXGz(t *testing.T) {
+	idx := []byte{
+		0, 0, 0x08, 3,
+		0, 0, 0, 2,
+		0, 0, 0, 1,
+		0, 0, 0, 3,
+		0, 1, 2,
+		255, 128, 7,
+	}
+
+	m, err := loadIDXGz(bytes.NewReader(gzipped(idx)))
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if m.Equal(dense.New(2, 3)(0, 1, 2, 255, 128, 7)) {
+		return
+	}
+
+	t.Fatal("The matrix loaded from IDX is wrong.")
+}
+
+func TestLoadIDXGzFailsForOtherTypes(t *testing.T) {
+	idx := []byte{
+		0, 0, 0x0d, 1,
+		0, 0, 0, 1,
+		0, 0, 0, 0,
+	}
+
+	_, err := loadIDXGz(bytes.NewReader(gzipped(idx)))
+
+	if err != nil && err.Error() == InvalidIDXError {
+		return
+	}
+
+	t.Fatalf("The error %s should occur, but %v occurred.", InvalidIDXError, err)
+}
+
+func TestLoadMatrixMarketInTarGzFailsForMissingMember(t *testing.T) {
+	archive := tarGzipped([]string{"other.mtx"}, [][]byte{[]byte("")})
+
+	_, err := loadMatrixMarketInTarGz(bytes.NewReader(archive), "small/small.mtx")
+
+	if err != nil && err.Error() == NotFoundInArchiveError {
+		return
+	}
+
+	t.Fatalf("The error %s should occur, but %v occurred.", NotFoundInArchiveError, err)
+}
+
+func TestLoadIDXGzFailsForOverflowingDimensions(t *testing.T) {
+	idx := []byte{
+		0, 0, 0x08, 3,
+		0, 0, 0, 1,
+		0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff,
+	}
+
+	_, err := loadIDXGz(bytes.NewReader(gzipped(idx)))
+
+	if err != nil && err.Error() == dense.SizeOverflowError {
+		return
+	}
+
+	t.Fatalf("The error %s should occur, but %v occurred.", dense.SizeOverflowError, err)
+}
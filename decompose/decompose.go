@@ -0,0 +1,101 @@
+/*
+Package "decompose" provides matrix decompositions.
+Each decomposition returns a typed result,
+so that follow-up computations can reuse the factorization.
+*/
+package decompose
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/workspace"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	// The maximum number of Jacobi sweeps.
+	maxSweeps = 64
+
+	// The machine epsilon of float64.
+	epsilon = 2.220446049250313e-16
+)
+
+/*
+columns is a column-major working storage for the Jacobi iterations.
+The columns are taken from the workspace, and should be returned with "release".
+*/
+type columns [][]float64
+
+func newColumns(m types.Matrix) columns {
+	rows, n := m.Shape()
+
+	c := make(columns, n)
+	for j := range c {
+		c[j] = workspace.Get(rows)
+		for i := range c[j] {
+			c[j][i] = m.Get(i, j)
+		}
+	}
+
+	return c
+}
+
+func identityColumns(n int) columns {
+	c := make(columns, n)
+	for j := range c {
+		c[j] = workspace.Get(n)
+		c[j][j] = 1
+	}
+
+	return c
+}
+
+// Return the columns to the workspace.
+func (c columns) release() {
+	for _, column := range c {
+		workspace.Put(column)
+	}
+}
+
+// Apply the plane rotation to the "p"-th and "q"-th columns.
+func (c columns) rotate(p, q int, cos, sin float64) {
+	for i := range c[p] {
+		x, y := c[p][i], c[q][i]
+		c[p][i] = cos*x - sin*y
+		c[q][i] = sin*x + cos*y
+	}
+}
+
+// Create a new dense matrix from the columns listed in "order".
+func (c columns) matrix(order []int) *dense.Matrix {
+	m := dense.Zeros(len(c[0]), len(order))
+
+	for j, k := range order {
+		for i, element := range c[k] {
+			m.Update(i, j, element)
+		}
+	}
+
+	return m
+}
+
+func dot(x, y []float64) float64 {
+	var d float64
+	for i := range x {
+		d += x[i] * y[i]
+	}
+
+	return d
+}
+
+// Compute the Jacobi rotation which annihilates the off-diagonal element "gamma"
+// of the symmetric 2 x 2 matrix [[alpha, gamma], [gamma, beta]].
+func jacobiRotation(alpha, beta, gamma float64) (cos, sin float64) {
+	zeta := (beta - alpha) / (2 * gamma)
+	t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+
+	cos = 1 / math.Sqrt(1+t*t)
+
+	return cos, cos * t
+}
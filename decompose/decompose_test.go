@@ -0,0 +1,124 @@
+package decompose
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Compute U * diag(S) * V^T.
+func reconstruct(u types.Matrix, s []float64, v types.Matrix) types.Matrix {
+	us := dense.Zeros(u.Rows(), u.Columns()).Add(u)
+
+	for j, value := range s {
+		for i := 0; i < u.Rows(); i++ {
+			us.Update(i, j, us.Get(i, j)*value)
+		}
+	}
+
+	return us.Multiply(v.Transpose())
+}
+
+func TestSVDReconstructsMatrix(t *testing.T) {
+	tests := []types.Matrix{
+		dense.New(3, 2)(
+			1, 2,
+			3, 4,
+			5, 6,
+		),
+		dense.New(2, 3)(
+			2, 0, 1,
+			-1, 3, 0,
+		),
+	}
+
+	for i, m := range tests {
+		r := SVD(m)
+
+		if !reconstruct(r.U(), r.S(), r.V()).EqualApprox(m, 1e-12) {
+			t.Fatalf("The %d-th decomposition should reconstruct the original matrix.", i)
+		}
+	}
+}
+
+func TestSVDSortsSingularValues(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 0, 0,
+		0, 3, 0,
+		0, 0, 2,
+	)
+
+	s := SVD(m).S()
+
+	if math.Abs(s[0]-3) > 1e-12 || math.Abs(s[1]-2) > 1e-12 || math.Abs(s[2]-1) > 1e-12 {
+		t.Fatalf("The singular values should be sorted in descending order, but are %v.", s)
+	}
+}
+
+func TestSVDRankAndCond(t *testing.T) {
+	m := dense.New(3, 2)(
+		1, 2,
+		2, 4,
+		3, 6,
+	)
+
+	r := SVD(m)
+
+	if rank := r.Rank(1e-10); rank != 1 {
+		t.Fatalf("The rank should be %d, but is %d.", 1, rank)
+	}
+
+	if cond := SVD(dense.New(2, 2)(4, 0, 0, 2)).Cond(); math.Abs(cond-2) > 1e-12 {
+		t.Fatalf("The condition number should be %v, but is %v.", 2, cond)
+	}
+}
+
+func TestSVDSolveFindsLeastSquaresSolution(t *testing.T) {
+	m := dense.New(3, 2)(
+		1, 0,
+		0, 1,
+		1, 1,
+	)
+
+	b := dense.New(3, 1)(1, 2, 4)
+
+	// The normal equation [[2, 1], [1, 2]] x = [5, 6] gives x = [4/3, 7/3].
+	x := dense.New(2, 1)(4.0/3, 7.0/3)
+
+	if SVD(m).Solve(b).EqualApprox(x, 1e-12) {
+		return
+	}
+
+	t.Fatal("Solve should return the least squares solution.")
+}
+
+func TestSymmetricEigenReconstructsMatrix(t *testing.T) {
+	m := dense.New(3, 3)(
+		4, 1, 2,
+		1, 3, 0,
+		2, 0, 5,
+	)
+
+	r := SymmetricEigen(m)
+
+	values := r.Values()
+	for i := 1; i < len(values); i++ {
+		if values[i-1] > values[i] {
+			t.Fatalf("The eigenvalues should be sorted in ascending order, but are %v.", values)
+		}
+	}
+
+	if !reconstruct(r.Vectors(), values, r.Vectors()).EqualApprox(m, 1e-12) {
+		t.Fatal("The eigendecomposition should reconstruct the original matrix.")
+	}
+}
+
+func TestSymmetricEigenValues(t *testing.T) {
+	values := SymmetricEigen(dense.New(2, 2)(2, 1, 1, 2)).Values()
+
+	if math.Abs(values[0]-1) > 1e-12 || math.Abs(values[1]-3) > 1e-12 {
+		t.Fatalf("The eigenvalues should be [1 3], but are %v.", values)
+	}
+}
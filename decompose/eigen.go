@@ -0,0 +1,122 @@
+package decompose
+
+import (
+	"math"
+	"sort"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+/*
+"EigenResult" is the eigendecomposition A = V * diag(Values) * V^T of a symmetric matrix,
+where the eigenvalues are sorted in ascending order
+and the columns of "V" are the corresponding orthonormal eigenvectors.
+*/
+type EigenResult struct {
+	values  []float64
+	vectors *dense.Matrix
+}
+
+// Compute the eigendecomposition of the symmetric matrix "m" with cyclic Jacobi rotations.
+// Only the symmetry of "m" is assumed, and it is not checked.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func SymmetricEigen(m types.Matrix) *EigenResult {
+	validates.ShapeShouldBeSquare(m)
+
+	a := newColumns(m)
+	v := identityColumns(m.Columns())
+
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		var off, diagonal float64
+		for p := range a {
+			diagonal += a[p][p] * a[p][p]
+			for q := range a {
+				if p != q {
+					off += a[p][q] * a[p][q]
+				}
+			}
+		}
+
+		if off <= epsilon*epsilon*diagonal {
+			break
+		}
+
+		for p := 0; p < len(a); p++ {
+			for q := p + 1; q < len(a); q++ {
+				if a[q][p] == 0 {
+					continue
+				}
+
+				cos, sin := jacobiRotation(a[p][p], a[q][q], a[q][p])
+
+				// Apply the rotation to both of columns and rows.
+				a.rotate(p, q, cos, sin)
+				for j := range a {
+					x, y := a[j][p], a[j][q]
+					a[j][p] = cos*x - sin*y
+					a[j][q] = sin*x + cos*y
+				}
+				v.rotate(p, q, cos, sin)
+			}
+		}
+	}
+
+	values := make([]float64, len(a))
+	for j := range a {
+		values[j] = a[j][j]
+	}
+
+	order := &valueOrder{values: values, indexes: make([]int, len(values))}
+	for j := range order.indexes {
+		order.indexes[j] = j
+	}
+	sort.Stable(order)
+
+	sorted := make([]float64, len(values))
+	for j, k := range order.indexes {
+		sorted[j] = values[k]
+	}
+
+	r := &EigenResult{
+		values:  sorted,
+		vectors: v.matrix(order.indexes),
+	}
+
+	a.release()
+	v.release()
+
+	return r
+}
+
+// Return the eigenvalues in ascending order.
+func (r *EigenResult) Values() []float64 {
+	values := make([]float64, len(r.values))
+	copy(values, r.values)
+
+	return values
+}
+
+// Return the orthonormal eigenvectors as columns.
+func (r *EigenResult) Vectors() *dense.Matrix {
+	return r.vectors
+}
+
+// Return the condition number in the 2-norm,
+// which is the ratio of the largest absolute eigenvalue to the smallest.
+// When the smallest absolute eigenvalue is zero, +Inf is returned.
+func (r *EigenResult) Cond() float64 {
+	min, max := math.Inf(1), 0.0
+
+	for _, value := range r.values {
+		min = math.Min(min, math.Abs(value))
+		max = math.Max(max, math.Abs(value))
+	}
+
+	if min == 0 {
+		return math.Inf(1)
+	}
+
+	return max / min
+}
@@ -0,0 +1,191 @@
+package decompose
+
+import (
+	"math"
+	"sort"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/internal/workspace"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+/*
+"SVDResult" is the singular value decomposition A = U * diag(S) * V^T
+of a "rows x columns" matrix, where k = min(rows, columns),
+"U" is "rows x k", "V" is "columns x k", and "S" is sorted in descending order.
+*/
+type SVDResult struct {
+	u *dense.Matrix
+	s []float64
+	v *dense.Matrix
+}
+
+// Compute the thin singular value decomposition of "m" with one-sided Jacobi rotations.
+func SVD(m types.Matrix) *SVDResult {
+	if m.Rows() < m.Columns() {
+		r := SVD(m.Transpose())
+		return &SVDResult{u: r.v, s: r.s, v: r.u}
+	}
+
+	a := newColumns(m)
+	v := identityColumns(m.Columns())
+
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		rotated := false
+
+		for p := 0; p < len(a); p++ {
+			for q := p + 1; q < len(a); q++ {
+				alpha, beta, gamma := dot(a[p], a[p]), dot(a[q], a[q]), dot(a[p], a[q])
+
+				if gamma == 0 || math.Abs(gamma) <= epsilon*math.Sqrt(alpha*beta) {
+					continue
+				}
+				rotated = true
+
+				cos, sin := jacobiRotation(alpha, beta, gamma)
+				a.rotate(p, q, cos, sin)
+				v.rotate(p, q, cos, sin)
+			}
+		}
+
+		if !rotated {
+			break
+		}
+	}
+
+	s := make([]float64, len(a))
+	for j := range a {
+		s[j] = math.Sqrt(dot(a[j], a[j]))
+
+		if s[j] == 0 {
+			continue
+		}
+
+		for i := range a[j] {
+			a[j][i] /= s[j]
+		}
+	}
+
+	order := &valueOrder{values: s, indexes: make([]int, len(s)), descending: true}
+	for j := range order.indexes {
+		order.indexes[j] = j
+	}
+	sort.Stable(order)
+
+	sorted := make([]float64, len(s))
+	for j, k := range order.indexes {
+		sorted[j] = s[k]
+	}
+
+	r := &SVDResult{
+		u: a.matrix(order.indexes),
+		s: sorted,
+		v: v.matrix(order.indexes),
+	}
+
+	a.release()
+	v.release()
+
+	return r
+}
+
+// Return the left singular vectors as columns.
+func (r *SVDResult) U() *dense.Matrix {
+	return r.u
+}
+
+// Return the singular values in descending order.
+func (r *SVDResult) S() []float64 {
+	s := make([]float64, len(r.s))
+	copy(s, r.s)
+
+	return s
+}
+
+// Return the right singular vectors as columns.
+func (r *SVDResult) V() *dense.Matrix {
+	return r.v
+}
+
+// Return the number of singular values greater than "tolerance".
+func (r *SVDResult) Rank(tolerance float64) int {
+	rank := 0
+
+	for _, s := range r.s {
+		if s > tolerance {
+			rank++
+		}
+	}
+
+	return rank
+}
+
+// Return the condition number in the 2-norm, which is the ratio of the largest singular value to the smallest.
+// When the smallest singular value is zero, +Inf is returned.
+func (r *SVDResult) Cond() float64 {
+	min := r.s[len(r.s)-1]
+	if min == 0 {
+		return math.Inf(1)
+	}
+
+	return r.s[0] / min
+}
+
+// Compute the minimum-norm least squares solution X of A * X = B with the pseudo-inverse.
+// Singular values not greater than max(rows, columns) * S[0] * machine epsilon are treated as zero.
+// When the rows of "b" don't equal to the ones of A,
+// validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func (r *SVDResult) Solve(b types.Matrix) *dense.Matrix {
+	validates.ShapeShouldBeMultipliable(r.u.Transpose(), b)
+
+	rows, columns := r.u.Rows(), r.v.Rows()
+	size := rows
+	if columns > size {
+		size = columns
+	}
+	tolerance := float64(size) * r.s[0] * epsilon
+
+	// Compute diag(1 / S) * U^T * B on the workspace, and then multiply V from the left.
+	k, n := len(r.s), b.Columns()
+
+	elements := workspace.Get(k * n)
+	defer workspace.Put(elements)
+
+	c := dense.MultiplyTo(dense.NewFromGeneral(dense.General{Rows: k, Cols: n, Stride: n, Data: elements}), r.u.Transpose(), b)
+
+	for j, s := range r.s {
+		if s > tolerance {
+			c.Row(j).(*dense.Matrix).Apply(func(element float64) float64 { return element / s })
+		} else {
+			c.Row(j).(*dense.Matrix).Apply(func(element float64) float64 { return 0 })
+		}
+	}
+
+	return r.v.Multiply(c).(*dense.Matrix)
+}
+
+/*
+valueOrder sorts indexes by their values.
+*/
+type valueOrder struct {
+	values     []float64
+	indexes    []int
+	descending bool
+}
+
+func (o *valueOrder) Len() int {
+	return len(o.indexes)
+}
+
+func (o *valueOrder) Less(i, j int) bool {
+	if o.descending {
+		return o.values[o.indexes[i]] > o.values[o.indexes[j]]
+	}
+
+	return o.values[o.indexes[i]] < o.values[o.indexes[j]]
+}
+
+func (o *valueOrder) Swap(i, j int) {
+	o.indexes[i], o.indexes[j] = o.indexes[j], o.indexes[i]
+}
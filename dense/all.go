@@ -1,22 +1,22 @@
 package dense
 
 import (
-	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/shape"
 )
 
 type allCursor struct {
 	matrix  *Matrix
 	element float64
-	current *types.Index
-	next    *types.Index
+	current *shape.Index
+	next    *shape.Index
 }
 
 func newAllCursor(matrix *Matrix) *allCursor {
 	c := &allCursor{
 		matrix:  matrix,
 		element: 0,
-		current: types.NewIndex(0, 0),
-		next:    types.NewIndex(0, 0),
+		current: shape.NewIndex(0, 0),
+		next:    shape.NewIndex(0, 0),
 	}
 
 	return c
@@ -32,12 +32,12 @@ func (c *allCursor) HasNext() bool {
 	index := c.matrix.base.Columns()*(c.matrix.offset.Row()+c.current.Row()) + c.matrix.offset.Column() + c.current.Column()
 	c.element = c.matrix.elements[index]
 
-	c.next = types.NewIndex(c.current.Row()+1, c.current.Column())
+	c.next = shape.NewIndex(c.current.Row()+1, c.current.Column())
 	if c.next.Row() < c.matrix.view.Rows() {
 		return true
 	}
 
-	c.next = types.NewIndex(0, c.current.Column()+1)
+	c.next = shape.NewIndex(0, c.current.Column()+1)
 
 	return true
 }
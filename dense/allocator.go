@@ -0,0 +1,133 @@
+package dense
+
+import (
+	"sync"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+const (
+	// The default number of elements of slabs allocated by "Arena".
+	defaultSlabSize = 1 << 20
+)
+
+/*
+"Allocator" is the interface for allocating the backing slices of new matrices.
+It is passed to "NewFrom" and "ZerosFrom" explicitly,
+so matrices created by other callers and by operations are not affected.
+*/
+type Allocator interface {
+	// Return a slice of "size" zeros.
+	Allocate(size int) []float64
+}
+
+/*
+"HeapAllocator" allocates slices with "make", which is used by "New" and "Zeros".
+*/
+type HeapAllocator struct{}
+
+func (a HeapAllocator) Allocate(size int) []float64 {
+	return make([]float64, size)
+}
+
+/*
+"Arena" allocates slices from large slabs, and frees all of them at once with "Reset".
+This avoids the cost of allocating and collecting thousands of short-lived matrices in batch jobs.
+Only matrices created with "(*Arena).New", "(*Arena).Zeros" or the arena passed to "NewFrom" and "ZerosFrom"
+are allocated from it, and they must not be used after "Reset".
+Results of operations are allocated as usual, so write them into matrices of the arena with "MultiplyTo" and so on.
+*/
+type Arena struct {
+	mutex    sync.Mutex
+	slabSize int
+	slabs    [][]float64
+	slab     int
+	used     int
+}
+
+// Create a new arena allocating slabs of 1 << 20 elements.
+func NewArena() *Arena {
+	return NewArenaWithSlabSize(defaultSlabSize)
+}
+
+// Create a new arena allocating slabs of "slabSize" elements.
+// Slices larger than "slabSize" are allocated from their own slabs.
+// When "slabSize" is not positive, validates.NON_POSITIVE_SIZE_PANIC will be caused.
+func NewArenaWithSlabSize(slabSize int) *Arena {
+	validates.ShapeShouldBePositive(slabSize, 1)
+
+	a := &Arena{
+		slabSize: slabSize,
+	}
+
+	return a
+}
+
+// Create a new matrix with given elements from the arena as "NewFrom".
+func (a *Arena) New(rows, columns int) func(elements ...float64) *Matrix {
+	return NewFrom(a, rows, columns)
+}
+
+// Create a new zero matrix from the arena as "ZerosFrom".
+func (a *Arena) Zeros(rows, columns int) *Matrix {
+	return ZerosFrom(a, rows, columns)
+}
+
+// Return a slice of "size" zeros carved from the current slab.
+// Slabs are allocated or reused from those before "Reset" when the current one has no room.
+func (a *Arena) Allocate(size int) []float64 {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	for ; a.slab < len(a.slabs); a.slab, a.used = a.slab+1, 0 {
+		if slab := a.slabs[a.slab]; len(slab)-a.used >= size {
+			b := slab[a.used : a.used+size : a.used+size]
+			a.used += size
+
+			for i := range b {
+				b[i] = 0
+			}
+
+			return b
+		}
+	}
+
+	slab := make([]float64, a.slabSize)
+	if size > a.slabSize {
+		slab = make([]float64, size)
+	}
+	a.slabs = append(a.slabs, slab)
+	a.used = size
+
+	return slab[:size:size]
+}
+
+// Free all of the slices allocated from the arena at once.
+// The slabs are kept and reused by the following "Allocate".
+func (a *Arena) Reset() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.slab, a.used = 0, 0
+}
+
+// Release the slabs, so that they are collected.
+func (a *Arena) Release() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.slabs, a.slab, a.used = nil, 0, 0
+}
+
+// Return the number of elements of the slabs held by the arena.
+func (a *Arena) Capacity() int {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	capacity := 0
+	for _, slab := range a.slabs {
+		capacity += len(slab)
+	}
+
+	return capacity
+}
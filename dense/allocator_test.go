@@ -0,0 +1,77 @@
+package dense
+
+import (
+	"testing"
+)
+
+func TestArenaAllocatesMatrices(t *testing.T) {
+	a := NewArenaWithSlabSize(64)
+
+	m := a.New(3, 4)(
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12,
+	)
+
+	n := ZerosFrom(a, 4, 2)
+	n.Update(0, 0, 1).Update(3, 1, 2)
+
+	r := MultiplyTo(a.Zeros(3, 2), m, n)
+
+	if a.Capacity() != 64 {
+		t.Fatalf("The arena should hold one slab of 64 elements, but holds %d elements.", a.Capacity())
+	}
+
+	if !r.Equal(New(3, 2)(1, 8, 5, 16, 9, 24)) {
+		t.Fatal("The product written into the arena is wrong.")
+	}
+
+	// Matrices created without the arena are not allocated from it.
+	Zeros(8, 8)
+
+	if a.Capacity() == 64 {
+		return
+	}
+
+	t.Fatal("Matrices created by \"Zeros\" should not be allocated from the arena.")
+}
+
+func TestArenaReusesSlabsAfterReset(t *testing.T) {
+	a := NewArenaWithSlabSize(16)
+
+	x := a.Allocate(10)
+	for index := range x {
+		x[index] = 1
+	}
+
+	a.Allocate(100)
+	a.Reset()
+
+	y := a.Allocate(10)
+
+	if &x[0] != &y[0] || a.Capacity() != 116 {
+		t.Fatal("The slabs should be reused after reset.")
+	}
+
+	for _, element := range y {
+		if element != 0 {
+			t.Fatal("The slice reused from the arena should be zeros.")
+		}
+	}
+
+	if cap(y) != 10 {
+		t.Fatal("The slice should not have room over the next one.")
+	}
+}
+
+func TestArenaReleasesSlabs(t *testing.T) {
+	a := NewArenaWithSlabSize(16)
+	a.Allocate(10)
+	a.Release()
+
+	if a.Capacity() == 0 {
+		return
+	}
+
+	t.Fatal("The arena should hold no slab after release.")
+}
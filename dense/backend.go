@@ -0,0 +1,125 @@
+package dense
+
+import (
+	"errors"
+	"math"
+	"sync/atomic"
+
+	"github.com/mitsuse/matrix-go/backend"
+	"github.com/mitsuse/matrix-go/internal/kernels"
+)
+
+const (
+	SingularError = "SingularError"
+)
+
+// The engine running the kernels of Multiply, Add, Subtract, Scalar and Solve on row-major slices.
+// It is replaced by the BLAS one when built with the tag "cblas" or "gonum".
+var engine = newEngineValue(GoEngine{})
+
+// "atomic.Value" requires the same concrete type every time, so engines are wrapped.
+type engineHolder struct {
+	backend.Engine
+}
+
+func newEngineValue(e backend.Engine) *atomic.Value {
+	v := &atomic.Value{}
+	v.Store(engineHolder{e})
+
+	return v
+}
+
+// Return the name of the engine for operations on the backing slices,
+// which is "cblas" or "gonum" when built with the tag of the same name and "go" otherwise.
+// When both of the tags are given, "cblas" is used.
+func Backend() string {
+	return Engine().Name()
+}
+
+// Return the engine for operations on the backing slices.
+func Engine() backend.Engine {
+	return engine.Load().(engineHolder).Engine
+}
+
+// Replace the engine for operations on the backing slices with "e".
+// Operations running at the same time keep the engine which they have started with.
+// When "e" is nil, "GoEngine" is used.
+func SetEngine(e backend.Engine) {
+	if e == nil {
+		e = GoEngine{}
+	}
+
+	engine.Store(engineHolder{e})
+}
+
+/*
+"GoEngine" is the engine implemented in pure Go, which is used by default.
+Other engines can embed it to implement only some of the kernels.
+*/
+type GoEngine struct{}
+
+func (e GoEngine) Name() string {
+	return "go"
+}
+
+func (e GoEngine) Multiply(transA, transB bool, rows, inner, columns int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) {
+	multiplyBlockedKernel(transA, transB, rows, inner, columns, a, lda, b, ldb, c, ldc)
+}
+
+func (e GoEngine) Add(alpha float64, x, y []float64) {
+	kernels.Axpy(alpha, x, y)
+}
+
+func (e GoEngine) Scale(alpha float64, x []float64) {
+	kernels.Scale(alpha, x)
+}
+
+// Solve the equations by Gaussian elimination with partial pivoting.
+// When a pivot is zero, SingularError is returned.
+func (e GoEngine) Solve(size, columns int, a []float64, lda int, b []float64, ldb int) error {
+	for k := 0; k < size; k++ {
+		pivot := k
+		for i := k + 1; i < size; i++ {
+			if math.Abs(a[i*lda+k]) > math.Abs(a[pivot*lda+k]) {
+				pivot = i
+			}
+		}
+
+		if a[pivot*lda+k] == 0 {
+			return errors.New(SingularError)
+		}
+
+		if pivot != k {
+			swap(a[k*lda:k*lda+size], a[pivot*lda:pivot*lda+size])
+			swap(b[k*ldb:k*ldb+columns], b[pivot*ldb:pivot*ldb+columns])
+		}
+
+		for i := k + 1; i < size; i++ {
+			factor := a[i*lda+k] / a[k*lda+k]
+			if factor == 0 {
+				continue
+			}
+
+			kernels.Axpy(-factor, a[k*lda+k:k*lda+size], a[i*lda+k:i*lda+size])
+			kernels.Axpy(-factor, b[k*ldb:k*ldb+columns], b[i*ldb:i*ldb+columns])
+		}
+	}
+
+	for k := size - 1; k >= 0; k-- {
+		row := b[k*ldb : k*ldb+columns]
+
+		for j := k + 1; j < size; j++ {
+			kernels.Axpy(-a[k*lda+j], b[j*ldb:j*ldb+columns], row)
+		}
+
+		kernels.Scale(1/a[k*lda+k], row)
+	}
+
+	return nil
+}
+
+func swap(x, y []float64) {
+	for i := range x {
+		x[i], y[i] = y[i], x[i]
+	}
+}
@@ -0,0 +1,89 @@
+package dense
+
+import (
+	"testing"
+)
+
+func TestBackendMultipliesAsPureGo(t *testing.T) {
+	rows, inner, columns := 70, 90, 65
+
+	for _, trans := range [][2]bool{{false, false}, {true, false}, {false, true}, {true, true}} {
+		a, lda := sequence(rows, inner).elements, inner
+		if trans[0] {
+			a, lda = sequence(inner, rows).elements, rows
+		}
+
+		b, ldb := sequence(inner, columns).elements, columns
+		if trans[1] {
+			b, ldb = sequence(columns, inner).elements, inner
+		}
+
+		expected := make([]float64, rows*columns)
+		multiplyBlockedKernel(trans[0], trans[1], rows, inner, columns, a, lda, b, ldb, expected, columns)
+
+		actual := make([]float64, rows*columns)
+		Engine().Multiply(trans[0], trans[1], rows, inner, columns, a, lda, b, ldb, actual, columns)
+
+		for index := range expected {
+			if actual[index] != expected[index] {
+				t.Fatalf("The backend %q should compute the same product as pure Go for %v.", Backend(), trans)
+			}
+		}
+	}
+}
+
+type countingEngine struct {
+	GoEngine
+	multiplications int
+}
+
+func (e *countingEngine) Multiply(transA, transB bool, rows, inner, columns int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) {
+	e.multiplications++
+	e.GoEngine.Multiply(transA, transB, rows, inner, columns, a, lda, b, ldb, c, ldc)
+}
+
+func TestSetEngineReplacesKernels(t *testing.T) {
+	defer SetEngine(Engine())
+
+	e := &countingEngine{}
+	SetEngine(e)
+
+	m := sequence(70, 80)
+	n := sequence(80, 60)
+
+	if !m.Multiply(n).Equal(multiplySlowly(m, n)) {
+		t.Fatal("The product computed by the replaced engine is wrong.")
+	}
+
+	if e.multiplications == 1 && Backend() == "go" {
+		return
+	}
+
+	t.Fatalf("The replaced engine should multiply once, but multiplied %d times.", e.multiplications)
+}
+
+func TestSetEngineIsSafeDuringOperations(t *testing.T) {
+	defer SetEngine(Engine())
+
+	m := sequence(70, 80)
+	n := sequence(80, 60)
+	r := multiplySlowly(m, n)
+
+	done := make(chan bool)
+
+	go func() {
+		for i := 0; i < 10; i++ {
+			SetEngine(&countingEngine{})
+		}
+
+		done <- true
+	}()
+
+	for i := 0; i < 10; i++ {
+		if !m.Multiply(n).Equal(r) {
+			t.Fatal("The product computed while replacing the engine is wrong.")
+		}
+	}
+
+	<-done
+}
@@ -0,0 +1,32 @@
+package dense
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Serialize the matrix "m" and encode it as a base64 string,
+// which can be embedded in configuration files or environment variables.
+func EncodeString(m types.Matrix) (string, error) {
+	buffer := bytes.NewBuffer([]byte{})
+
+	encoder := base64.NewEncoder(base64.StdEncoding, buffer)
+
+	if err := m.Serialize(encoder); err != nil {
+		return "", err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// Decode a base64 string generated with EncodeString and deserialize a matrix from it.
+func DecodeString(s string) (types.Matrix, error) {
+	return Deserialize(base64.NewDecoder(base64.StdEncoding, strings.NewReader(s)))
+}
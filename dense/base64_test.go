@@ -0,0 +1,35 @@
+package dense
+
+import (
+	"testing"
+)
+
+func TestDecodeStringReadsEncodedString(t *testing.T) {
+	m := New(3, 3)(
+		1.0, 0.1, 0.9,
+		0.1, 2.5, 0.2,
+		0.2, 0.1, 3.1,
+	).View(1, 1, 2, 1).Transpose()
+
+	s, err := EncodeString(m)
+	if err != nil {
+		t.Fatalf("An expected error occured on encoding: %s", err)
+	}
+
+	n, err := DecodeString(s)
+	if err != nil {
+		t.Fatalf("An expected error occured on decoding: %s", err)
+	}
+
+	if !m.Base().Equal(n.Base()) || !m.Equal(n) {
+		t.Fatal("Decoding failed for an encoded matrix.")
+	}
+}
+
+func TestDecodeStringFailsForInvalidBase64(t *testing.T) {
+	if _, err := DecodeString("!!!"); err != nil {
+		return
+	}
+
+	t.Fatal("Decoding invalid base64 string should fail.")
+}
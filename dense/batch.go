@@ -0,0 +1,90 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/internal/parallel"
+	"github.com/mitsuse/matrix-go/internal/rewriters"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Batch" is a sequence of matrices of the same shape stored in one backing slice,
+which is the layout of a 3-D array of "Len" x "Rows" x "Columns".
+The matrix at "index" occupies the elements from index*rows*columns in row-major order.
+*/
+type Batch struct {
+	count    int
+	rows     int
+	columns  int
+	elements []float64
+}
+
+// Create a new batch of "count" zero matrices of "rows" x "columns".
+// When "count", "rows" or "columns" is not positive, validates.NON_POSITIVE_SIZE_PANIC will be caused,
+// and when the size of elements overflows int, validates.SIZE_OVERFLOW_PANIC will be caused.
+func NewBatch(count, rows, columns int) *Batch {
+	validates.ShapeShouldBePositive(rows, columns)
+	validates.ShapeShouldBePositive(count, 1)
+	validates.ShapeShouldNotOverflow(rows, columns)
+	validates.ShapeShouldNotOverflow(count, rows*columns)
+
+	b := &Batch{
+		count:    count,
+		rows:     rows,
+		columns:  columns,
+		elements: make([]float64, count*rows*columns),
+	}
+
+	return b
+}
+
+// Return the number of matrices.
+func (b *Batch) Len() int {
+	return b.count
+}
+
+// Return the shape of each matrix.
+func (b *Batch) Shape() (rows, columns int) {
+	return b.rows, b.columns
+}
+
+// Return the rows of each matrix.
+func (b *Batch) Rows() int {
+	return b.rows
+}
+
+// Return the columns of each matrix.
+func (b *Batch) Columns() int {
+	return b.columns
+}
+
+// Return the matrix at "index", which shares the backing storage with the batch.
+// When "index" is out of range, validates.OUT_OF_RANGE_PANIC will be caused.
+func (b *Batch) At(index int) *Matrix {
+	validates.IndexShouldBeInRange(b.count, 1, index, 0)
+
+	size := b.rows * b.columns
+
+	m := &Matrix{}
+	m.initializeCompact(b.rows, b.columns, b.elements[index*size:(index+1)*size], rewriters.Reflect(), 0)
+
+	return m
+}
+
+// Write the products of the corresponding matrices of "a" and "b" into "dst" in parallel, and return "dst".
+// Each product is computed by "MultiplyTo", so no result matrix is allocated.
+// When the lengths of "dst", "a" and "b" differ or "dst" is not of a.Rows() x b.Columns(),
+// validates.DIFFERENT_SIZE_PANIC will be caused,
+// and when the matrices of "a" and "b" are not multipliable, validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func MultiplyBatch(dst, a, b *Batch) *Batch {
+	validates.ShapeShouldBeMultipliable(a, b)
+
+	if a.count != b.count || dst.count != a.count || dst.rows != a.rows || dst.columns != b.columns {
+		panic(validates.DIFFERENT_SIZE_PANIC)
+	}
+
+	parallel.For(dst.count, func(index int) {
+		MultiplyTo(dst.At(index), a.At(index), b.At(index))
+	})
+
+	return dst
+}
@@ -0,0 +1,48 @@
+package dense
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestMultiplyBatchMultipliesEachPair(t *testing.T) {
+	a := NewBatch(5, 3, 4)
+	b := NewBatch(5, 4, 2)
+
+	for index := 0; index < a.Len(); index++ {
+		a.At(index).Add(sequence(3, 4).Scalar(float64(index)))
+		b.At(index).Add(sequence(4, 2))
+	}
+
+	dst := MultiplyBatch(NewBatch(5, 3, 2), a, b)
+
+	for index := 0; index < dst.Len(); index++ {
+		if !dst.At(index).Equal(multiplySlowly(a.At(index), b.At(index))) {
+			t.Fatalf("The product at %d is wrong.", index)
+		}
+	}
+}
+
+func TestBatchAtSharesStorage(t *testing.T) {
+	b := NewBatch(2, 2, 2)
+	b.At(1).Update(0, 1, 3)
+
+	if b.At(1).Get(0, 1) == 3 && b.At(0).Equal(Zeros(2, 2)) {
+		return
+	}
+
+	t.Fatal("The matrix at the index should share the backing storage of the batch.")
+}
+
+func TestMultiplyBatchCausesPanicForDifferentLength(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatal("The batches of different lengths should cause a panic.")
+	}()
+
+	MultiplyBatch(NewBatch(2, 2, 2), NewBatch(2, 2, 2), NewBatch(3, 2, 2))
+}
@@ -89,6 +89,39 @@ func BenchmarkMultiply(b *testing.B) {
 	}
 }
 
+func BenchmarkAdditionLarge(b *testing.B) {
+	m := sequence(512, 512)
+	n := sequence(512, 512)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Add(n)
+	}
+}
+
+func BenchmarkMultiplyLarge(b *testing.B) {
+	m := sequence(256, 256)
+	n := sequence(256, 256).Transpose().(*Matrix).View(0, 0, 256, 256).(*Matrix)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Multiply(n)
+	}
+}
+
+func BenchmarkMultiplyTransposeLarge(b *testing.B) {
+	m := sequence(512, 512)
+	n := m.Transpose()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Multiply(n)
+	}
+}
+
 func BenchmarkScalar(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
@@ -106,3 +139,113 @@ func BenchmarkScalar(b *testing.B) {
 		m.Scalar(s)
 	}
 }
+
+func BenchmarkEqual(b *testing.B) {
+	m := Zeros(64, 64)
+	n := Zeros(64, 64)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Equal(n)
+	}
+}
+
+func BenchmarkEqualTranspose(b *testing.B) {
+	m := Zeros(64, 64)
+	n := Zeros(64, 64).Transpose()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Equal(n)
+	}
+}
+
+func BenchmarkAdditionTranspose(b *testing.B) {
+	m := Zeros(64, 64)
+	n := Zeros(64, 64).Transpose()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Add(n)
+	}
+}
+
+func BenchmarkMultiplySmall(b *testing.B) {
+	m := New(4, 4)(
+		0, -1, 2, 3,
+		4.1, 5, -6, 7.4,
+		-8, 9.2, 0, -1.1,
+		1, 0.5, -0.25, 2,
+	)
+
+	n := sequence(4, 4)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Multiply(n)
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	m := sequence(64, 64)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.MarshalJSON()
+	}
+}
+
+func BenchmarkGobEncode(b *testing.B) {
+	m := sequence(64, 64)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.GobEncode()
+	}
+}
+
+func BenchmarkMarshalMsgpack(b *testing.B) {
+	m := sequence(64, 64)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.MarshalMsgpack()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	data, _ := sequence(64, 64).MarshalJSON()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		(&Matrix{}).UnmarshalJSON(data)
+	}
+}
+
+func BenchmarkGobDecode(b *testing.B) {
+	data, _ := sequence(64, 64).GobEncode()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		(&Matrix{}).GobDecode(data)
+	}
+}
+
+func BenchmarkUnmarshalMsgpack(b *testing.B) {
+	data, _ := sequence(64, 64).MarshalMsgpack()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		(&Matrix{}).UnmarshalMsgpack(data)
+	}
+}
@@ -0,0 +1,75 @@
+//go:build cblas
+// +build cblas
+
+package dense
+
+/*
+#cgo linux LDFLAGS: -lopenblas
+#cgo darwin LDFLAGS: -framework Accelerate
+
+// The prototypes are declared here instead of including cblas.h,
+// whose location differs between OpenBLAS and Accelerate.
+extern void cblas_dgemm(int order, int transA, int transB, int m, int n, int k,
+	double alpha, const double *a, int lda, const double *b, int ldb,
+	double beta, double *c, int ldc);
+extern void cblas_daxpy(int n, double alpha, const double *x, int incx, double *y, int incy);
+extern void cblas_dscal(int n, double alpha, double *x, int incx);
+*/
+import "C"
+
+const (
+	cblasRowMajor = 101
+	cblasNoTrans  = 111
+	cblasTrans    = 112
+)
+
+func init() {
+	SetEngine(cblasEngine{})
+}
+
+/*
+"cblasEngine" runs the kernels with CBLAS, and solves equations with "GoEngine".
+*/
+type cblasEngine struct {
+	GoEngine
+}
+
+func (e cblasEngine) Name() string {
+	return "cblas"
+}
+
+func (e cblasEngine) Multiply(transA, transB bool, rows, inner, columns int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) {
+	C.cblas_dgemm(
+		cblasRowMajor, cblasTranspose(transA), cblasTranspose(transB),
+		C.int(rows), C.int(columns), C.int(inner),
+		1, (*C.double)(&a[0]), C.int(lda),
+		(*C.double)(&b[0]), C.int(ldb),
+		0, (*C.double)(&c[0]), C.int(ldc),
+	)
+}
+
+func cblasTranspose(trans bool) C.int {
+	if trans {
+		return cblasTrans
+	}
+
+	return cblasNoTrans
+}
+
+func (e cblasEngine) Add(alpha float64, x, y []float64) {
+	if len(x) == 0 {
+		return
+	}
+
+	y = y[:len(x)]
+
+	C.cblas_daxpy(C.int(len(x)), C.double(alpha), (*C.double)(&x[0]), 1, (*C.double)(&y[0]), 1)
+}
+
+func (e cblasEngine) Scale(alpha float64, x []float64) {
+	if len(x) == 0 {
+		return
+	}
+
+	C.cblas_dscal(C.int(len(x)), C.double(alpha), (*C.double)(&x[0]), 1)
+}
@@ -0,0 +1,208 @@
+package dense
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+
+	"github.com/mitsuse/matrix-go/internal/rewriters"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	InvalidChunkError = "InvalidChunkError"
+)
+
+const (
+	// The first bytes of streams written by "SerializeChunked".
+	ChunkedMagic = "MTXCHNK1"
+)
+
+const (
+	// The maximum number of elements allocated before they are read from a chunk.
+	chunkReadSize = 1 << 16
+)
+
+// Serialize "m" as a stream of chunks, each of which has "chunkRows" rows at most.
+// The stream starts with the magic "MTXCHNK1", the rows, the columns and "chunkRows",
+// and each chunk consists of the number of elements and the elements in row-major order.
+// All numbers are little-endian, and integers are uint64.
+// "chunkRows" larger than the rows of "m" is written as the rows.
+// When "chunkRows" is not positive, validates.NON_POSITIVE_SIZE_PANIC will be caused.
+func SerializeChunked(writer io.Writer, m types.Matrix, chunkRows int) error {
+	validates.ShapeShouldBePositive(chunkRows, 1)
+
+	rows, columns := m.Shape()
+
+	// The chunk is allocated for "chunkRows" rows, so it is bounded by the rows.
+	chunkRows = minInt(chunkRows, rows)
+
+	w := bufio.NewWriter(writer)
+	w.WriteString(ChunkedMagic)
+
+	if err := binary.Write(w, binary.LittleEndian, []uint64{uint64(rows), uint64(columns), uint64(chunkRows)}); err != nil {
+		return err
+	}
+
+	chunk := make([]float64, 0, chunkRows*columns)
+
+	for begin := 0; begin < rows; begin += chunkRows {
+		chunk = chunk[:0]
+
+		for row := begin; row < begin+chunkRows && row < rows; row++ {
+			for column := 0; column < columns; column++ {
+				chunk = append(chunk, m.Get(row, column))
+			}
+		}
+
+		if err := binary.Write(w, binary.LittleEndian, uint64(len(chunk))); err != nil {
+			return err
+		}
+
+		if err := binary.Write(w, binary.LittleEndian, chunk); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+/*
+"ChunkReader" reads a stream written by "SerializeChunked" chunk by chunk,
+so that row ranges are processed without holding the whole matrix.
+*/
+type ChunkReader struct {
+	reader    io.Reader
+	rows      int
+	columns   int
+	chunkRows int
+	row       int
+}
+
+// Create a new reader of chunks, reading and validating the header.
+// When the header is malformed, InvalidChunkError is returned.
+func NewChunkReader(reader io.Reader) (*ChunkReader, error) {
+	magic := make([]byte, len(ChunkedMagic))
+	if _, err := io.ReadFull(reader, magic); err != nil || string(magic) != ChunkedMagic {
+		return nil, errors.New(InvalidChunkError)
+	}
+
+	header := make([]uint64, 3)
+	if err := binary.Read(reader, binary.LittleEndian, header); err != nil {
+		return nil, errors.New(InvalidChunkError)
+	}
+
+	for _, value := range header {
+		if value == 0 || value > math.MaxInt32 {
+			return nil, errors.New(InvalidChunkError)
+		}
+	}
+
+	rows, columns := int(header[0]), int(header[1])
+
+	if err := CheckShape(rows, columns); err != nil {
+		return nil, err
+	}
+
+	r := &ChunkReader{
+		reader:    reader,
+		rows:      rows,
+		columns:   columns,
+		chunkRows: int(header[2]),
+	}
+
+	return r, nil
+}
+
+// Return the shape of the whole matrix.
+func (r *ChunkReader) Shape() (rows, columns int) {
+	return r.rows, r.columns
+}
+
+// Read the next chunk as a new matrix and the index of its first row in the whole matrix.
+// When all chunks have been read, io.EOF is returned,
+// when the length of the chunk is inconsistent with the header, InvalidChunkError is returned,
+// and when the chunk is truncated, CorruptedDataError is returned.
+func (r *ChunkReader) Next() (row int, m *Matrix, err error) {
+	if r.row >= r.rows {
+		return 0, nil, io.EOF
+	}
+
+	rows := r.chunkRows
+	if r.rows-r.row < rows {
+		rows = r.rows - r.row
+	}
+
+	var length uint64
+	if err := binary.Read(r.reader, binary.LittleEndian, &length); err != nil {
+		return 0, nil, errors.New(InvalidChunkError)
+	}
+
+	// The length is validated before the elements are allocated.
+	if length != uint64(rows*r.columns) {
+		return 0, nil, errors.New(InvalidChunkError)
+	}
+
+	elements, err := readChunkElements(r.reader, int(length))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	row = r.row
+	r.row += rows
+
+	m = &Matrix{}
+	m.initializeCompact(rows, r.columns, elements, rewriters.Reflect(), 0)
+
+	return row, m, nil
+}
+
+// Read "length" elements, growing the slice as they arrive,
+// so that a header claiming a huge chunk doesn't allocate it before the elements are read.
+func readChunkElements(reader io.Reader, length int) ([]float64, error) {
+	elements := make([]float64, 0, minInt(length, chunkReadSize))
+
+	for len(elements) < length {
+		block := make([]float64, minInt(length-len(elements), chunkReadSize))
+		if err := binary.Read(reader, binary.LittleEndian, block); err != nil {
+			return nil, &CorruptedDataError{}
+		}
+
+		elements = append(elements, block...)
+	}
+
+	return elements, nil
+}
+
+// Deserialize a matrix written by "SerializeChunked" from the given reader.
+// The elements are allocated as the chunks are validated,
+// so a broken stream is rejected without allocating the size claimed by the header.
+func DeserializeChunked(reader io.Reader) (types.Matrix, error) {
+	r, err := NewChunkReader(bufio.NewReader(reader))
+	if err != nil {
+		return nil, err
+	}
+
+	elements := []float64{}
+
+	for {
+		_, chunk, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, chunk.elements...)
+	}
+
+	m := &Matrix{}
+	m.initializeCompact(r.rows, r.columns, elements, rewriters.Reflect(), 0)
+
+	return m, nil
+}
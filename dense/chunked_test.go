@@ -0,0 +1,140 @@
+package dense
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestSerializeChunkedAndDeserializeChunkedReturnsTheOriginal(t *testing.T) {
+	matrices := []*Matrix{
+		sequence(1, 1),
+		sequence(7, 5),
+		sequence(20, 30).View(3, 4, 11, 13).Transpose().(*Matrix),
+	}
+
+	for _, m := range matrices {
+		for _, chunkRows := range []int{1, 3, 100} {
+			buffer := &bytes.Buffer{}
+
+			if err := SerializeChunked(buffer, m, chunkRows); err != nil {
+				t.Fatalf("An unexpected error %q occurred.", err)
+			}
+
+			n, err := DeserializeChunked(buffer)
+			if err != nil {
+				t.Fatalf("An unexpected error %q occurred.", err)
+			}
+
+			if !n.Equal(m) {
+				t.Fatal("The deserialized matrix should equal to the original.")
+			}
+		}
+	}
+}
+
+func TestSerializeChunkedBoundsChunkRowsByRows(t *testing.T) {
+	m := sequence(2, 2)
+
+	buffer := &bytes.Buffer{}
+
+	if err := SerializeChunked(buffer, m, math.MaxInt32); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	n, err := DeserializeChunked(buffer)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if n.Equal(m) {
+		return
+	}
+
+	t.Fatal("The deserialized matrix should equal to the original.")
+}
+
+func TestChunkReaderStreamsRowRanges(t *testing.T) {
+	m := sequence(7, 5)
+
+	buffer := &bytes.Buffer{}
+
+	if err := SerializeChunked(buffer, m, 3); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	r, err := NewChunkReader(buffer)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if rows, columns := r.Shape(); rows != 7 || columns != 5 {
+		t.Fatalf("The shape should be 7x5, but is %dx%d.", rows, columns)
+	}
+
+	expected := []int{0, 3, 6}
+
+	for _, begin := range expected {
+		row, chunk, err := r.Next()
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if row != begin || !chunk.Equal(m.View(begin, 0, chunk.Rows(), 5)) {
+			t.Fatalf("The chunk from the row %d is wrong.", begin)
+		}
+	}
+
+	if _, _, err := r.Next(); err != io.EOF {
+		t.Fatalf("The end of chunks should be io.EOF, but %v occurred.", err)
+	}
+}
+
+func TestDeserializeChunkedFailsForBrokenStream(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	if err := SerializeChunked(buffer, sequence(4, 4), 2); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	b := buffer.Bytes()
+
+	// The length of the first chunk claims more elements than the header allows.
+	broken := append([]byte{}, b...)
+	broken[len(ChunkedMagic)+3*8] = 0xff
+
+	streams := [][]byte{
+		b[:4],
+		broken,
+	}
+
+	for _, stream := range streams {
+		if _, err := DeserializeChunked(bytes.NewReader(stream)); err == nil || err.Error() != InvalidChunkError {
+			t.Fatalf("The broken stream should cause %s, but %v occurred.", InvalidChunkError, err)
+		}
+	}
+}
+
+func TestDeserializeChunkedFailsForTruncatedChunk(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	if err := SerializeChunked(buffer, sequence(4, 4), 2); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	b := buffer.Bytes()
+
+	// The header and the length claim a chunk of 4 x (2^31 - 1) elements, none of which follow.
+	huge := &bytes.Buffer{}
+	huge.WriteString(ChunkedMagic)
+	binary.Write(huge, binary.LittleEndian, []uint64{math.MaxInt32, 4, math.MaxInt32, 4 * math.MaxInt32})
+
+	for _, stream := range [][]byte{b[:len(b)-1], huge.Bytes()} {
+		_, err := DeserializeChunked(bytes.NewReader(stream))
+		if _, ok := err.(*CorruptedDataError); !ok {
+			t.Fatalf("The truncated chunk should cause CorruptedDataError, but %v occurred.", err)
+		}
+	}
+}
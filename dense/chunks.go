@@ -0,0 +1,51 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Split the iteration of all elements into at most "n" cursors of disjoint ranges of rows.
+// The cursors are independent, so they can be used in different goroutines
+// as long as the receiver is not rewritten concurrently.
+// Each cursor returns the rows and the columns of the receiver, not of the range.
+// When "n" is greater than the rows, as many cursors as the rows are returned.
+// When "n" is not positive, validates.NON_POSITIVE_SIZE_PANIC will be caused.
+func (m *Matrix) Chunks(n int) []types.Cursor {
+	validates.ShapeShouldBePositive(n, 1)
+
+	rows, columns := m.Shape()
+	n = minInt(n, rows)
+
+	cursors := make([]types.Cursor, n)
+
+	for index := range cursors {
+		begin, end := index*rows/n, (index+1)*rows/n
+
+		cursors[index] = &chunkCursor{
+			cursor: m.View(begin, 0, end-begin, columns).All(),
+			row:    begin,
+		}
+	}
+
+	return cursors
+}
+
+/*
+"chunkCursor" iterates all elements of a range of rows,
+returning the rows of the original matrix.
+*/
+type chunkCursor struct {
+	cursor types.Cursor
+	row    int
+}
+
+func (c *chunkCursor) HasNext() bool {
+	return c.cursor.HasNext()
+}
+
+func (c *chunkCursor) Get() (element float64, row, column int) {
+	element, row, column = c.cursor.Get()
+
+	return element, row + c.row, column
+}
@@ -0,0 +1,69 @@
+package dense
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+func TestChunksVisitAllElementsOnce(t *testing.T) {
+	tests := []*Matrix{
+		sequence(10, 3),
+		sequence(7, 9).View(1, 2, 5, 6).(*Matrix),
+		sequence(4, 7).Transpose().(*Matrix),
+	}
+
+	for _, m := range tests {
+		for n := 1; n <= m.Rows()+2; n++ {
+			cursors := m.Chunks(n)
+
+			if len(cursors) != minInt(n, m.Rows()) {
+				t.Fatalf("The number of chunks should be %d, but is %d.", minInt(n, m.Rows()), len(cursors))
+			}
+
+			visited := Zeros(m.Shape())
+
+			var group sync.WaitGroup
+
+			for _, cursor := range cursors {
+				group.Add(1)
+
+				go func(c types.Cursor) {
+					defer group.Done()
+
+					// Each goroutine rewrites only the rows of its chunk.
+					for c.HasNext() {
+						element, row, column := c.Get()
+
+						if element == m.Get(row, column) {
+							visited.Update(row, column, visited.Get(row, column)+1)
+						}
+					}
+				}(cursor)
+			}
+
+			group.Wait()
+
+			cursor := visited.All()
+			for cursor.HasNext() {
+				if count, row, column := cursor.Get(); count != 1 {
+					t.Fatalf("The element at (%d, %d) should be visited once with %d chunks, but is visited %v times.", row, column, n, count)
+				}
+			}
+		}
+	}
+}
+
+func TestChunksCausesPanicForNonPositiveNumber(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.NON_POSITIVE_SIZE_PANIC {
+			return
+		}
+
+		t.Fatal("The non-positive number of chunks should cause a panic.")
+	}()
+
+	sequence(2, 2).Chunks(0)
+}
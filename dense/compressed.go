@@ -0,0 +1,32 @@
+package dense
+
+import (
+	"compress/gzip"
+	"io"
+
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Serialize "m" compressed with gzip by using the given writer.
+// The writer receives the whole gzip stream when nil is returned.
+func SerializeCompressed(writer io.Writer, m types.Matrix) error {
+	compressed := gzip.NewWriter(writer)
+
+	if err := m.Serialize(compressed); err != nil {
+		compressed.Close()
+		return err
+	}
+
+	return compressed.Close()
+}
+
+// Deserialize a matrix written by "SerializeCompressed" from the given reader.
+func DeserializeCompressed(reader io.Reader) (types.Matrix, error) {
+	decompressed, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer decompressed.Close()
+
+	return Deserialize(decompressed)
+}
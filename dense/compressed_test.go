@@ -0,0 +1,51 @@
+package dense
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSerializeCompressedAndDeserializeCompressedReturnsTheOriginal(t *testing.T) {
+	m := Zeros(64, 64).View(1, 2, 30, 40).Transpose()
+
+	compressed := &bytes.Buffer{}
+
+	if err := SerializeCompressed(compressed, m); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	plain := &bytes.Buffer{}
+
+	if err := m.Serialize(plain); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if compressed.Len() >= plain.Len() {
+		t.Fatalf("The compressed size %d should be smaller than %d.", compressed.Len(), plain.Len())
+	}
+
+	n, err := DeserializeCompressed(compressed)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if n.Equal(m) {
+		return
+	}
+
+	t.Fatal("The deserialized matrix should equal to the original.")
+}
+
+func TestDeserializeCompressedFailsForUncompressed(t *testing.T) {
+	plain := &bytes.Buffer{}
+
+	if err := Zeros(2, 2).Serialize(plain); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if _, err := DeserializeCompressed(plain); err != nil {
+		return
+	}
+
+	t.Fatal("Deserializing the uncompressed matrix should fail.")
+}
@@ -10,27 +10,38 @@ import (
 	"math"
 
 	"github.com/mitsuse/matrix-go/internal/rewriters"
-	"github.com/mitsuse/matrix-go/internal/types"
 	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/shape"
+	"github.com/mitsuse/matrix-go/types"
 )
 
 type Matrix struct {
 	initialized bool
-	base        *types.Shape
-	view        *types.Shape
-	offset      *types.Index
+	base        *shape.Shape
+	view        *shape.Shape
+	offset      *shape.Index
 	elements    []float64
 	rewriter    rewriters.Rewriter
+	threshold   float64
 }
 
 // Create a new matrix with given elements.
 // When "rows" and "columns" is not positive,
-// validates.NON_POSITIVE_SIZE_PANIC will be caused.
+// validates.NON_POSITIVE_SIZE_PANIC will be caused,
+// and when the size of elements overflows int,
+// validates.SIZE_OVERFLOW_PANIC will be caused.
 // In addition,
 // when the product of "row"s and "column" doesn't equal to the size of "elements",
 // validates.INVALID_ELEMENTS_PANIC will be caused.
 func New(rows, columns int) func(elements ...float64) *Matrix {
+	return NewFrom(HeapAllocator{}, rows, columns)
+}
+
+// Create a new matrix with given elements as "New",
+// allocating the backing slice from "a".
+func NewFrom(a Allocator, rows, columns int) func(elements ...float64) *Matrix {
 	validates.ShapeShouldBePositive(rows, columns)
+	validates.ShapeShouldNotOverflow(rows, columns)
 
 	constructor := func(elements ...float64) *Matrix {
 		size := rows * columns
@@ -39,15 +50,15 @@ func New(rows, columns int) func(elements ...float64) *Matrix {
 			panic(validates.INVALID_ELEMENTS_PANIC)
 		}
 
-		shape := types.NewShape(rows, columns)
-		offset := types.NewIndex(0, 0)
+		s := shape.NewShape(rows, columns)
+		offset := shape.NewIndex(0, 0)
 
 		m := &Matrix{
 			initialized: true,
-			base:        shape,
-			view:        shape,
+			base:        s,
+			view:        s,
 			offset:      offset,
-			elements:    make([]float64, size),
+			elements:    a.Allocate(size),
 			rewriter:    rewriters.Reflect(),
 		}
 		copy(m.elements, elements)
@@ -60,9 +71,22 @@ func New(rows, columns int) func(elements ...float64) *Matrix {
 
 // Create a new zero matrix.
 // When "rows" and "columns" is not positive,
-// validates.NON_POSITIVE_SIZE_PANIC will be caused.
+// validates.NON_POSITIVE_SIZE_PANIC will be caused,
+// and when the size of elements overflows int,
+// validates.SIZE_OVERFLOW_PANIC will be caused.
 func Zeros(rows, columns int) *Matrix {
-	return New(rows, columns)(make([]float64, rows*columns)...)
+	return ZerosFrom(HeapAllocator{}, rows, columns)
+}
+
+// Create a new zero matrix as "Zeros", allocating the backing slice from "a".
+func ZerosFrom(a Allocator, rows, columns int) *Matrix {
+	validates.ShapeShouldBePositive(rows, columns)
+	validates.ShapeShouldNotOverflow(rows, columns)
+
+	m := &Matrix{}
+	m.initializeCompact(rows, columns, a.Allocate(rows*columns), rewriters.Reflect(), 0)
+
+	return m
 }
 
 // Convert the given matrix to *dense.Matrix.
@@ -96,15 +120,34 @@ func (m *Matrix) Serialize(writer io.Writer) error {
 }
 
 func (m *Matrix) MarshalJSON() ([]byte, error) {
+	return m.marshalJSONVersion(version)
+}
+
+func (m *Matrix) marshalJSONVersion(version int) ([]byte, error) {
 	jsonObject := matrixJson{
 		Version:  version,
 		Base:     m.base,
 		View:     m.view,
 		Offset:   m.offset,
-		Elements: m.elements,
 		Rewriter: m.rewriter.Type(),
 	}
 
+	if version >= 2 {
+		jsonObject.Data = encodeBits(m.elements)
+	} else {
+		jsonObject.Elements = m.elements
+	}
+
+	if version >= 1 {
+		threshold := m.threshold
+		jsonObject.Threshold = &threshold
+	}
+
+	if version >= 3 {
+		checksum := jsonObject.checksum()
+		jsonObject.Checksum = &checksum
+	}
+
 	return json.Marshal(&jsonObject)
 }
 
@@ -120,7 +163,45 @@ func (m *Matrix) UnmarshalJSON(b []byte) error {
 	}
 
 	if jsonObject.Version < minVersion || maxVersion < jsonObject.Version {
-		return errors.New(IncompatibleVersionError)
+		return &UnsupportedVersionError{Version: jsonObject.Version}
+	}
+
+	if jsonObject.Base == nil || jsonObject.View == nil || jsonObject.Offset == nil {
+		return errors.New(InvalidElementsError)
+	}
+
+	if err := CheckShape(jsonObject.Base.Rows(), jsonObject.Base.Columns()); err != nil {
+		return err
+	}
+
+	if jsonObject.Version >= 2 {
+		elements, err := decodeBits(jsonObject.Data)
+		if err != nil {
+			return err
+		}
+
+		jsonObject.Elements = elements
+	}
+
+	if jsonObject.Version >= 3 {
+		actual := jsonObject.checksum()
+
+		if jsonObject.Checksum == nil || *jsonObject.Checksum != actual {
+			e := &CorruptedDataError{Actual: actual}
+			if jsonObject.Checksum != nil {
+				e.Expected = *jsonObject.Checksum
+			}
+
+			return e
+		}
+	}
+
+	if len(jsonObject.Elements) != jsonObject.Base.Rows()*jsonObject.Base.Columns() {
+		return errors.New(InvalidElementsError)
+	}
+
+	if !isViewInBase(jsonObject.Base, jsonObject.View, jsonObject.Offset) {
+		return &CorruptedDataError{}
 	}
 
 	m.base = jsonObject.Base
@@ -134,17 +215,13 @@ func (m *Matrix) UnmarshalJSON(b []byte) error {
 	}
 	m.rewriter = rewriter
 
-	// TODO: Return error value instead of causing panic.
-	validates.ShapeShouldBePositive(m.base.Rows(), m.base.Columns())
-
-	validates.IndexShouldBeInRange(
-		m.base.Rows(),
-		m.base.Columns(),
-		m.offset.Row(),
-		m.offset.Column(),
-	)
+	if jsonObject.Threshold != nil {
+		if err := checkThreshold(*jsonObject.Threshold); err != nil {
+			return err
+		}
 
-	validates.ViewShouldBeInBase(m.base, m.view, m.offset)
+		m.threshold = *jsonObject.Threshold
+	}
 
 	m.initialized = true
 
@@ -177,6 +254,60 @@ func (m *Matrix) Diagonal() types.Cursor {
 	return newDiagonalCursor(m)
 }
 
+func (m *Matrix) AntiDiagonal() types.Cursor {
+	return newAntiDiagonalCursor(m)
+}
+
+func (m *Matrix) Diagonals(offset int) types.Cursor {
+	return newDiagonalsCursor(m, offset)
+}
+
+func (m *Matrix) NonZeroCount() int {
+	count := 0
+
+	for row := 0; row < m.view.Rows(); row++ {
+		begin, end := m.rowRange(row)
+
+		for _, element := range m.elements[begin:end] {
+			if !m.isZero(element) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func (m *Matrix) Density() float64 {
+	return float64(m.NonZeroCount()) / float64(m.view.Rows()*m.view.Columns())
+}
+
+func (m *Matrix) ZeroThreshold() float64 {
+	return m.threshold
+}
+
+func (m *Matrix) SetZeroThreshold(epsilon float64) types.Matrix {
+	validates.ThresholdShouldBeValid(epsilon)
+
+	m.threshold = epsilon
+	return m
+}
+
+// Check whether "epsilon" can be the zero threshold, that is, it is neither negative nor NaN.
+// Otherwise, InvalidThresholdError is returned.
+func checkThreshold(epsilon float64) error {
+	if epsilon >= 0 && !math.IsNaN(epsilon) {
+		return nil
+	}
+
+	return errors.New(InvalidThresholdError)
+}
+
+// Check whether "element" should be treated as zero.
+func (m *Matrix) isZero(element float64) bool {
+	return math.Abs(element) <= m.threshold
+}
+
 func (m *Matrix) Get(row, column int) (element float64) {
 	row, column = m.rewriter.Rewrite(row, column)
 
@@ -201,6 +332,24 @@ func (m *Matrix) Update(row, column int, element float64) types.Matrix {
 func (m *Matrix) Equal(n types.Matrix) bool {
 	validates.ShapeShouldBeSame(m, n)
 
+	if d, isDense := n.(*Matrix); isDense {
+		if d.rewriter == m.rewriter {
+			return m.equalElements(d)
+		}
+
+		equal := func(mIndex, nIndex int) bool {
+			return m.elements[mIndex] == d.elements[nIndex]
+		}
+
+		return m.eachPair(d, equal)
+	}
+
+	// "NonZeros" of "n" skips the elements within its zero threshold,
+	// so the non-zero elements are counted on both sides by the same rule only without the threshold.
+	if n.Capabilities().Has(types.Sparse) && n.ZeroThreshold() == 0 {
+		return m.equalNonZeros(n)
+	}
+
 	cursor := n.All()
 
 	for cursor.HasNext() {
@@ -213,56 +362,216 @@ func (m *Matrix) Equal(n types.Matrix) bool {
 	return true
 }
 
-func (m *Matrix) Add(n types.Matrix) types.Matrix {
+func (m *Matrix) EqualApprox(n types.Matrix, epsilon float64) bool {
+	match := func(x, y float64) bool {
+		return math.Abs(x-y) <= epsilon
+	}
+
+	return m.equalWith(n, match)
+}
+
+func (m *Matrix) EqualApproxRelative(n types.Matrix, epsilon float64) bool {
+	match := func(x, y float64) bool {
+		return math.Abs(x-y) <= epsilon*math.Max(math.Abs(x), math.Abs(y))
+	}
+
+	return m.equalWith(n, match)
+}
+
+func (m *Matrix) equalWith(n types.Matrix, match func(x, y float64) bool) bool {
 	validates.ShapeShouldBeSame(m, n)
 
+	cursor := n.All()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		if !match(m.Get(row, column), element) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Compare the backing elements of two matrices which have the same orientation,
+// returning on the first mismatch.
+func (m *Matrix) equalElements(n *Matrix) bool {
+	for row := 0; row < m.view.Rows(); row++ {
+		mBegin, mEnd := m.rowRange(row)
+		nBegin, _ := n.rowRange(row)
+
+		mRow := m.elements[mBegin:mEnd]
+		nRow := n.elements[nBegin : nBegin+len(mRow)]
+
+		for index, element := range mRow {
+			if nRow[index] != element {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Compare the receiver with the sparse matrix "n" visiting only the non-zero elements of "n",
+// assuming that the other elements of "n" are zero.
+// The receiver equals to "n" when the non-zero elements match
+// and the receiver has no other non-zero element, which is counted on the backing elements.
+func (m *Matrix) equalNonZeros(n types.Matrix) bool {
+	nonZeros := 0
+
 	cursor := n.NonZeros()
 
 	for cursor.HasNext() {
 		element, row, column := cursor.Get()
-		m.Update(row, column, m.Get(row, column)+element)
+		if m.Get(row, column) != element {
+			return false
+		}
+
+		if element != 0 {
+			nonZeros++
+		}
+	}
+
+	for row := 0; row < m.view.Rows(); row++ {
+		begin, end := m.rowRange(row)
+
+		for _, element := range m.elements[begin:end] {
+			if element == 0 {
+				continue
+			}
+
+			if nonZeros--; nonZeros < 0 {
+				return false
+			}
+		}
+	}
+
+	return nonZeros == 0
+}
+
+func (m *Matrix) Add(n types.Matrix) types.Matrix {
+	validates.ShapeShouldBeSame(m, n)
+
+	if d, isDense := n.(*Matrix); isDense && !m.sharesElements(d) {
+		if m.rewriter == d.rewriter {
+			e := Engine()
+			m.eachAlignedRow(d, func(mRow, nRow []float64) {
+				e.Add(1, nRow, mRow)
+			})
+
+			return m
+		}
+
+		add := func(mIndex, nIndex int) bool {
+			m.elements[mIndex] += d.elements[nIndex]
+
+			return true
+		}
+
+		m.eachPair(d, add)
+
+		return m
 	}
 
+	m.addNonZeros(1, n)
+
 	return m
 }
 
 func (m *Matrix) Subtract(n types.Matrix) types.Matrix {
 	validates.ShapeShouldBeSame(m, n)
 
-	cursor := n.NonZeros()
+	if d, isDense := n.(*Matrix); isDense && !m.sharesElements(d) {
+		if m.rewriter == d.rewriter {
+			e := Engine()
+			m.eachAlignedRow(d, func(mRow, nRow []float64) {
+				e.Add(-1, nRow, mRow)
+			})
 
-	for cursor.HasNext() {
-		element, row, column := cursor.Get()
-		m.Update(row, column, m.Get(row, column)-element)
+			return m
+		}
+
+		subtract := func(mIndex, nIndex int) bool {
+			m.elements[mIndex] -= d.elements[nIndex]
+
+			return true
+		}
+
+		m.eachPair(d, subtract)
+
+		return m
 	}
 
+	m.addNonZeros(-1, n)
+
 	return m
 }
 
 func (m *Matrix) Multiply(n types.Matrix) types.Matrix {
 	validates.ShapeShouldBeMultipliable(m, n)
 
-	rows := m.Rows()
-	columns := n.Columns()
+	if d, isDense := n.(*Matrix); isDense {
+		switch {
+		case m.isTransposeOf(d):
+			return m.multiplyTranspose()
+		case m.isSmallWith(d):
+			return m.multiplySmall(d)
+		case m.isLargeWith(d):
+			return m.multiplyStrassen(d)
+		default:
+			return m.multiplyBlocked(d)
+		}
+	}
+
+	return m.multiplyNonZeros(n)
+}
 
-	r := Zeros(rows, columns)
+func (m *Matrix) Scalar(s float64) types.Matrix {
+	Engine().Scale(s, m.elements)
 
-	cursor := n.NonZeros()
+	return m
+}
+
+func (m *Matrix) SetWhere(mask types.Matrix, value float64) types.Matrix {
+	validates.ShapeShouldBeSame(m, mask)
+
+	cursor := mask.NonZeros()
 
 	for cursor.HasNext() {
-		element, j, k := cursor.Get()
+		_, row, column := cursor.Get()
+		m.Update(row, column, value)
+	}
+
+	return m
+}
+
+func (m *Matrix) Clip(min, max float64) types.Matrix {
+	clip := func(element float64) float64 {
+		if element < min {
+			return min
+		}
 
-		for i := 0; i < rows; i++ {
-			r.Update(i, k, r.Get(i, k)+m.Get(i, j)*element)
+		if element > max {
+			return max
 		}
+
+		return element
 	}
 
-	return r
+	return m.Apply(clip)
 }
 
-func (m *Matrix) Scalar(s float64) types.Matrix {
-	for index, element := range m.elements {
-		m.elements[index] = element * s
+// Replace every element of the receiver view with the result of "f".
+// The backing elements are visited directly in a single pass.
+// The receiver is rewritten and returned.
+func (m *Matrix) Apply(f func(element float64) float64) types.Matrix {
+	for row := 0; row < m.view.Rows(); row++ {
+		begin, end := m.rowRange(row)
+
+		for index := begin; index < end; index++ {
+			m.elements[index] = f(m.elements[index])
+		}
 	}
 
 	return m
@@ -276,6 +585,7 @@ func (m *Matrix) Transpose() types.Matrix {
 		offset:      m.offset,
 		elements:    m.elements,
 		rewriter:    m.rewriter.Transpose(),
+		threshold:   m.threshold,
 	}
 
 	return n
@@ -285,8 +595,8 @@ func (m *Matrix) View(row, column, rows, columns int) types.Matrix {
 	row, column = m.rewriter.Rewrite(row, column)
 	rows, columns = m.rewriter.Rewrite(rows, columns)
 
-	offset := types.NewIndex(m.offset.Row()+row, m.offset.Column()+column)
-	view := types.NewShape(rows, columns)
+	offset := shape.NewIndex(m.offset.Row()+row, m.offset.Column()+column)
+	view := shape.NewShape(rows, columns)
 
 	validates.ShapeShouldBePositive(rows, columns)
 	validates.ViewShouldBeInBase(m.base, view, offset)
@@ -298,6 +608,7 @@ func (m *Matrix) View(row, column, rows, columns int) types.Matrix {
 		offset:      offset,
 		elements:    m.elements,
 		rewriter:    m.rewriter,
+		threshold:   m.threshold,
 	}
 
 	return n
@@ -308,9 +619,10 @@ func (m *Matrix) Base() types.Matrix {
 		initialized: true,
 		base:        m.base,
 		view:        m.base,
-		offset:      types.NewIndex(0, 0),
+		offset:      shape.NewIndex(0, 0),
 		elements:    m.elements,
 		rewriter:    m.rewriter,
+		threshold:   m.threshold,
 	}
 
 	return n
@@ -326,7 +638,7 @@ func (m *Matrix) Column(column int) types.Matrix {
 
 func (m *Matrix) Max() (element float64, row, column int) {
 	max := math.Inf(-1)
-	index := types.NewIndex(0, 0)
+	index := shape.NewIndex(0, 0)
 
 	cursor := m.All()
 
@@ -338,7 +650,7 @@ func (m *Matrix) Max() (element float64, row, column int) {
 		}
 
 		max = element
-		index = types.NewIndex(row, column)
+		index = shape.NewIndex(row, column)
 	}
 
 	return max, index.Row(), index.Column()
@@ -346,7 +658,7 @@ func (m *Matrix) Max() (element float64, row, column int) {
 
 func (m *Matrix) Min() (element float64, row, column int) {
 	max := math.Inf(1)
-	index := types.NewIndex(0, 0)
+	index := shape.NewIndex(0, 0)
 
 	cursor := m.All()
 
@@ -358,8 +670,17 @@ func (m *Matrix) Min() (element float64, row, column int) {
 		}
 
 		max = element
-		index = types.NewIndex(row, column)
+		index = shape.NewIndex(row, column)
 	}
 
 	return max, index.Row(), index.Column()
 }
+
+// Return the range of the backing elements which belong to the given row of the view.
+// The row is counted before rewriting, so it is a row of the base for transposed matrices.
+func (m *Matrix) rowRange(row int) (begin, end int) {
+	begin = (row+m.offset.Row())*m.base.Columns() + m.offset.Column()
+	end = begin + m.view.Columns()
+
+	return begin, end
+}
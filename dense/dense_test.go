@@ -3,11 +3,13 @@ package dense
 import (
 	"bytes"
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/mitsuse/matrix-go/internal/rewriters"
-	"github.com/mitsuse/matrix-go/internal/types"
 	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/shape"
+	"github.com/mitsuse/matrix-go/types"
 )
 
 type constructTest struct {
@@ -284,9 +286,9 @@ func TestUnmarshalJSONFailsWithAlreadyInitializedMatrix(t *testing.T) {
 func TestUnmarshalJSONFailsWithIncompatibleVersion(t *testing.T) {
 	m := &matrixJson{
 		Version: 99999,
-		Base:    types.NewShape(3, 3),
-		View:    types.NewShape(2, 1),
-		Offset:  types.NewIndex(1, 1),
+		Base:    shape.NewShape(3, 3),
+		View:    shape.NewShape(2, 1),
+		Offset:  shape.NewIndex(1, 1),
 		Elements: []float64{
 			1.0, 0.1, 0.9,
 			0.1, 2.5, 0.2,
@@ -307,19 +309,22 @@ func TestUnmarshalJSONFailsWithIncompatibleVersion(t *testing.T) {
 func TestUnmarshalJSONFailsWithUnknownRewriter(t *testing.T) {
 	m := &matrixJson{
 		Version: version,
-		Base:    types.NewShape(3, 3),
-		View:    types.NewShape(2, 1),
-		Offset:  types.NewIndex(1, 1),
-		Elements: []float64{
+		Base:    shape.NewShape(3, 3),
+		View:    shape.NewShape(2, 1),
+		Offset:  shape.NewIndex(1, 1),
+		Data: encodeBits([]float64{
 			1.0, 0.1, 0.9,
 			0.1, 2.5, 0.2,
 			0.2, 0.1, 3.1,
-		},
+		}),
 		Rewriter: 255,
 	}
 
 	n := &Matrix{}
 
+	checksum := m.checksum()
+	m.Checksum = &checksum
+
 	b, _ := json.Marshal(m)
 
 	// TODO: Use an exported error constant.
@@ -328,6 +333,60 @@ func TestUnmarshalJSONFailsWithUnknownRewriter(t *testing.T) {
 	}
 }
 
+func TestUnmarshalJSONFailsWithNegativeThreshold(t *testing.T) {
+	threshold := -1.0
+
+	m := &matrixJson{
+		Version:   version,
+		Base:      shape.NewShape(1, 2),
+		View:      shape.NewShape(1, 2),
+		Offset:    shape.NewIndex(0, 0),
+		Data:      encodeBits([]float64{1, 2}),
+		Threshold: &threshold,
+	}
+
+	checksum := m.checksum()
+	m.Checksum = &checksum
+
+	b, _ := json.Marshal(m)
+
+	if err := json.Unmarshal(b, &Matrix{}); err == nil || err.Error() != InvalidThresholdError {
+		t.Fatalf("The negative threshold should cause %s.", InvalidThresholdError)
+	}
+}
+
+func TestUnmarshalJSONFailsWithViewOutOfBase(t *testing.T) {
+	tests := []struct {
+		view   *shape.Shape
+		offset *shape.Index
+	}{
+		{view: shape.NewShape(1, 1), offset: shape.NewIndex(2, 0)},
+		{view: shape.NewShape(1, 1), offset: shape.NewIndex(0, -1)},
+		{view: shape.NewShape(2, 2), offset: shape.NewIndex(1, 0)},
+		{view: shape.NewShape(0, 1), offset: shape.NewIndex(0, 0)},
+		{view: shape.NewShape(1, math.MaxInt64), offset: shape.NewIndex(0, 1)},
+	}
+
+	for _, test := range tests {
+		m := &matrixJson{
+			Version: version,
+			Base:    shape.NewShape(2, 2),
+			View:    test.view,
+			Offset:  test.offset,
+			Data:    encodeBits([]float64{1, 2, 3, 4}),
+		}
+
+		checksum := m.checksum()
+		m.Checksum = &checksum
+
+		b, _ := json.Marshal(m)
+
+		if _, ok := json.Unmarshal(b, &Matrix{}).(*CorruptedDataError); !ok {
+			t.Fatalf("The view %v at %v out of the base should cause *CorruptedDataError.", test.view, test.offset)
+		}
+	}
+}
+
 func TestShapeReturnsTheNumberOfRowsAndColumns(t *testing.T) {
 	test := &constructTest{
 		rows:     3,
@@ -465,6 +524,68 @@ func TestNonZerosCreatesCursorToIterateNonZeroElements(t *testing.T) {
 	}
 }
 
+func TestNonZerosSkipsElementsWithinZeroThreshold(t *testing.T) {
+	m := New(2, 2)(
+		1e-12, 1,
+		-1e-12, -2,
+	).SetZeroThreshold(1e-9).View(0, 0, 2, 2)
+
+	count := 0
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, _, _ := cursor.Get()
+		if element != 1 && element != -2 {
+			t.Fatalf("Cursor should skip %v within the zero threshold.", element)
+		}
+		count++
+	}
+
+	if count != 2 || m.NonZeroCount() != 2 {
+		t.Fatal("Elements within the zero threshold should be treated as zero.")
+	}
+}
+
+func TestAddAndSubtractIgnoreZeroThreshold(t *testing.T) {
+	d := New(2, 2)(
+		0.3, 1,
+		2, 0.3,
+	).SetZeroThreshold(0.5)
+
+	if !Zeros(2, 2).Add(d).Equal(New(2, 2)(0.3, 1, 2, 0.3)) {
+		t.Fatal("Addition should add the elements within the zero threshold.")
+	}
+
+	if !Zeros(2, 2).Subtract(d.Transpose()).Equal(New(2, 2)(-0.3, -2, -1, -0.3)) {
+		t.Fatal("Subtraction should subtract the elements within the zero threshold.")
+	}
+}
+
+func TestSetZeroThresholdCausesPanicForInvalidEpsilon(t *testing.T) {
+	for _, epsilon := range []float64{-1e-9, math.NaN()} {
+		func() {
+			defer func() {
+				if p := recover(); p != validates.INVALID_THRESHOLD_PANIC {
+					t.Fatalf("The threshold %v should cause %s.", epsilon, validates.INVALID_THRESHOLD_PANIC)
+				}
+			}()
+
+			Zeros(2, 2).SetZeroThreshold(epsilon)
+		}()
+	}
+}
+
+func TestZeroThresholdIsSharedWithTranspose(t *testing.T) {
+	m := Zeros(2, 2).SetZeroThreshold(0.5).Transpose()
+
+	if m.ZeroThreshold() == 0.5 {
+		return
+	}
+
+	t.Fatal("The transpose should share the zero threshold.")
+}
+
 func TestDiagonalCreatesCursorToIterateDiagonalElements(t *testing.T) {
 	m := New(3, 3)(
 		1, 0, 0,
@@ -509,6 +630,174 @@ func TestDiagonalCreatesCursorToIterateDiagonalElements(t *testing.T) {
 	}
 }
 
+func TestAntiDiagonalCreatesCursorToIterateAntiDiagonalElements(t *testing.T) {
+	m := New(3, 4)(
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 0, 1, 2,
+	).View(0, 1, 3, 3)
+
+	checkTable := [][]bool{
+		[]bool{true, true, false},
+		[]bool{true, false, true},
+		[]bool{false, true, true},
+	}
+
+	cursor := m.AntiDiagonal()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+
+		if e := m.Get(row, column); element != e {
+			t.Fatalf(
+				"The element at (%d, %d) should be %v, but the cursor returns %v.",
+				row,
+				column,
+				e,
+				element,
+			)
+		}
+
+		if checked := checkTable[row][column]; checked {
+			t.Fatalf("Cursor should visit (%d, %d) more than necessary.", row, column)
+		}
+		checkTable[row][column] = true
+	}
+
+	for row, checkRow := range checkTable {
+		for column, checked := range checkRow {
+			if checked {
+				continue
+			}
+
+			t.Fatalf("Cursor didn't visit (%d, %d).", row, column)
+		}
+	}
+}
+
+func TestDiagonalsCreatesCursorToIterateElementsAboveDiagonal(t *testing.T) {
+	m := New(3, 4)(
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 0, 1, 2,
+	)
+
+	checkTable := [][]bool{
+		[]bool{true, true, false, true},
+		[]bool{true, true, true, false},
+		[]bool{true, true, true, true},
+	}
+
+	cursor := m.Diagonals(2)
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+
+		if e := m.Get(row, column); element != e {
+			t.Fatalf(
+				"The element at (%d, %d) should be %v, but the cursor returns %v.",
+				row,
+				column,
+				e,
+				element,
+			)
+		}
+
+		if checked := checkTable[row][column]; checked {
+			t.Fatalf("Cursor should visit (%d, %d) more than necessary.", row, column)
+		}
+		checkTable[row][column] = true
+	}
+
+	for row, checkRow := range checkTable {
+		for column, checked := range checkRow {
+			if checked {
+				continue
+			}
+
+			t.Fatalf("Cursor didn't visit (%d, %d).", row, column)
+		}
+	}
+}
+
+func TestDiagonalsCreatesCursorToIterateElementsBelowDiagonal(t *testing.T) {
+	m := New(3, 4)(
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 0, 1, 2,
+	)
+
+	checkTable := [][]bool{
+		[]bool{true, true, true, true},
+		[]bool{false, true, true, true},
+		[]bool{true, false, true, true},
+	}
+
+	cursor := m.Diagonals(-1)
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+
+		if e := m.Get(row, column); element != e {
+			t.Fatalf(
+				"The element at (%d, %d) should be %v, but the cursor returns %v.",
+				row,
+				column,
+				e,
+				element,
+			)
+		}
+
+		if checked := checkTable[row][column]; checked {
+			t.Fatalf("Cursor should visit (%d, %d) more than necessary.", row, column)
+		}
+		checkTable[row][column] = true
+	}
+
+	for row, checkRow := range checkTable {
+		for column, checked := range checkRow {
+			if checked {
+				continue
+			}
+
+			t.Fatalf("Cursor didn't visit (%d, %d).", row, column)
+		}
+	}
+}
+
+func TestDiagonalsCreatesEmptyCursorForTooLargeOffset(t *testing.T) {
+	m := Zeros(3, 4)
+
+	if !m.Diagonals(4).HasNext() && !m.Diagonals(-3).HasNext() {
+		return
+	}
+
+	t.Fatal("Cursor should not visit any element for the offset out of the matrix.")
+}
+
+func TestNonZeroCountCountsNonZeroElementsOfView(t *testing.T) {
+	m := New(3, 3)(
+		1, 0, 2,
+		0, 3, 0,
+		4, 0, 0,
+	).View(1, 0, 2, 2)
+
+	if count := m.NonZeroCount(); count != 2 {
+		t.Fatalf("The number of non-zero elements should be %d, but is %d.", 2, count)
+	}
+}
+
+func TestDensityIsTheRatioOfNonZeroElements(t *testing.T) {
+	m := New(2, 2)(
+		1, 0,
+		0, 3,
+	)
+
+	if density := m.Density(); density != 0.5 {
+		t.Fatalf("The density should be %v, but is %v.", 0.5, density)
+	}
+}
+
 func TestGetFailsByAccessingWithTooLargeRow(t *testing.T) {
 	rows, columns := 8, 6
 	viewRows, viewColumns := 4, 3
@@ -791,6 +1080,49 @@ func TestEqualCausesPanicForDifferentShapeMatrices(t *testing.T) {
 	m.Equal(n)
 }
 
+func TestEqualApproxIsTrueWithinTolerance(t *testing.T) {
+	m := New(2, 2)(
+		0, 1,
+		2, 3,
+	)
+
+	n := New(2, 2)(
+		0.05, 0.95,
+		2, 3.1,
+	).Transpose().Transpose()
+
+	if m.EqualApprox(n, 0.1+1e-12) && !m.EqualApprox(n, 0.05) {
+		return
+	}
+
+	t.Fatal("EqualApprox should compare elements with the absolute tolerance.")
+}
+
+func TestEqualApproxRelativeIsTrueWithinTolerance(t *testing.T) {
+	m := New(1, 3)(0, 1000, 0.001)
+	n := New(1, 3)(0, 1001, 0.0011)
+
+	if m.EqualApproxRelative(n, 0.1) && !m.EqualApproxRelative(n, 0.01) {
+		return
+	}
+
+	t.Fatal("EqualApproxRelative should compare elements with the relative tolerance.")
+}
+
+func TestEqualApproxCausesPanicForDifferentShapeMatrices(t *testing.T) {
+	defer func() {
+		if r := recover(); r == validates.DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatalf(
+			"Checking equality of matrices which have different shape should cause %s.",
+			validates.DIFFERENT_SIZE_PANIC,
+		)
+	}()
+	Zeros(2, 3).EqualApprox(Zeros(3, 2), 1)
+}
+
 func TestAddReturnsTheOriginal(t *testing.T) {
 	m := New(4, 3)(
 		0, 1, 2,
@@ -1048,6 +1380,95 @@ func TestScalarTheResultOfMultiplication(t *testing.T) {
 	t.Fatal("Mutable matrix should multiply each element of itselt by scalar.")
 }
 
+func TestSetWhereReplacesMaskedElements(t *testing.T) {
+	m := New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	)
+
+	mask := New(3, 2)(
+		1, 0,
+		0, 1,
+		1, 0,
+	).Transpose()
+
+	r := New(2, 3)(
+		-1, 1, -1,
+		3, -1, 5,
+	)
+
+	if m.SetWhere(mask, -1).Equal(r) {
+		return
+	}
+
+	t.Fatal("SetWhere should replace the elements at which the mask is non-zero.")
+}
+
+func TestSetWhereCausesPanicForDifferentShapeMask(t *testing.T) {
+	defer func() {
+		if r := recover(); r == validates.DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatalf("The mask which has different shape should cause %s.", validates.DIFFERENT_SIZE_PANIC)
+	}()
+	Zeros(2, 3).SetWhere(Zeros(3, 2), 1)
+}
+
+func TestClipReturnsTheOriginal(t *testing.T) {
+	m := Zeros(2, 2)
+
+	if m.Clip(-1, 1) == m {
+		return
+	}
+
+	t.Fatal("Clip should return the original matrix.")
+}
+
+func TestClipBoundsElementsOfView(t *testing.T) {
+	m := New(3, 4)(
+		9, 9, 9, 9,
+		9, -3, 0.5, 9,
+		9, 2, -1, 9,
+	)
+
+	m.View(1, 1, 2, 2).Clip(-1, 1)
+
+	r := New(3, 4)(
+		9, 9, 9, 9,
+		9, -1, 0.5, 9,
+		9, 1, -1, 9,
+	)
+
+	if m.Equal(r) {
+		return
+	}
+
+	t.Fatal("Clip should bound only the elements of the view.")
+}
+
+func TestApplyRewritesElementsOfView(t *testing.T) {
+	m := New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	)
+
+	m.View(0, 1, 2, 2).Transpose().(*Matrix).Apply(func(element float64) float64 {
+		return element * element
+	})
+
+	r := New(2, 3)(
+		1, 4, 9,
+		4, 25, 36,
+	)
+
+	if m.Equal(r) {
+		return
+	}
+
+	t.Fatal("Apply should rewrite only the elements of the view.")
+}
+
 func TestMaxFindsTheMaximumElements(t *testing.T) {
 	m := New(4, 3)(
 		0, 1, 2,
@@ -0,0 +1,100 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Write the sum of "a" and "b" into "dst", and return "dst".
+// Unlike "Add", neither "a" nor "b" is rewritten even if they are mutable.
+// When "a" or "b" shares the backing storage with "dst" other than being "dst" itself,
+// it is copied before "dst" is rewritten.
+// When the shapes of "dst", "a" and "b" differ, validates.DIFFERENT_SIZE_PANIC will be caused.
+func AddTo(dst *Matrix, a, b types.Matrix) *Matrix {
+	validates.ShapeShouldBeSame(a, b)
+	validates.ShapeShouldBeSame(dst, a)
+
+	b = dst.detach(b)
+	dst.assign(a)
+	dst.Add(b)
+
+	return dst
+}
+
+// Write the difference of "a" and "b" into "dst", and return "dst".
+// Unlike "Subtract", neither "a" nor "b" is rewritten even if they are mutable.
+// When "a" or "b" shares the backing storage with "dst" other than being "dst" itself,
+// it is copied before "dst" is rewritten.
+// When the shapes of "dst", "a" and "b" differ, validates.DIFFERENT_SIZE_PANIC will be caused.
+func SubtractTo(dst *Matrix, a, b types.Matrix) *Matrix {
+	validates.ShapeShouldBeSame(a, b)
+	validates.ShapeShouldBeSame(dst, a)
+
+	b = dst.detach(b)
+	dst.assign(a)
+	dst.Subtract(b)
+
+	return dst
+}
+
+// Write "a" multiplied by the scalar "s" into "dst", and return "dst".
+// Unlike "Scalar", "a" is not rewritten even if it is mutable,
+// and only the elements in the view of "dst" are rewritten.
+// When the shapes of "dst" and "a" differ, validates.DIFFERENT_SIZE_PANIC will be caused.
+func ScaleTo(dst *Matrix, s float64, a types.Matrix) *Matrix {
+	validates.ShapeShouldBeSame(dst, a)
+
+	dst.assign(a)
+
+	e := Engine()
+	dst.eachAlignedRow(dst, func(row, _ []float64) {
+		e.Scale(s, row)
+	})
+
+	return dst
+}
+
+// Overwrite the elements of the receiver with those of "n", and return the receiver.
+// Nothing is done when "n" is the receiver itself.
+func (m *Matrix) assign(n types.Matrix) *Matrix {
+	d, isDense := n.(*Matrix)
+	if !isDense {
+		rows, columns := m.Shape()
+
+		for i := 0; i < rows; i++ {
+			for j := 0; j < columns; j++ {
+				m.Update(i, j, n.Get(i, j))
+			}
+		}
+
+		return m
+	}
+
+	switch {
+	case d == m:
+	case m.sharesElements(d):
+		m.assign(m.detach(d))
+	case m.rewriter == d.rewriter:
+		m.eachAlignedRow(d, func(mRow, nRow []float64) {
+			copy(mRow, nRow)
+		})
+	default:
+		m.eachPair(d, func(mIndex, nIndex int) bool {
+			m.elements[mIndex] = d.elements[nIndex]
+
+			return true
+		})
+	}
+
+	return m
+}
+
+// Return "n" itself, or a copy of it when it shares the backing storage with the receiver.
+func (m *Matrix) detach(n types.Matrix) types.Matrix {
+	d, isDense := n.(*Matrix)
+	if !isDense || !m.sharesElements(d) {
+		return n
+	}
+
+	return Zeros(d.Shape()).assign(d)
+}
@@ -0,0 +1,136 @@
+package dense
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestAddToKeepsOperands(t *testing.T) {
+	m := sequence(3, 4)
+	n := sequence(4, 3).Transpose().(*Matrix)
+
+	dst := Zeros(3, 4)
+
+	if r := AddTo(dst, m, n); r != dst || !r.Equal(sequence(3, 4).Add(n)) {
+		t.Fatal("The sum written into the destination is wrong.")
+	}
+
+	if !m.Equal(sequence(3, 4)) || !n.Equal(sequence(4, 3).Transpose()) {
+		t.Fatal("The operands should not be rewritten.")
+	}
+}
+
+func TestSubtractToKeepsOperands(t *testing.T) {
+	m := sequence(3, 4)
+	n := New(3, 4)(
+		1, 1, 1, 1,
+		2, 2, 2, 2,
+		3, 3, 3, 3,
+	)
+
+	dst := Zeros(3, 4)
+
+	r := New(3, 4)(
+		-1, 0, 1, 2,
+		2, 3, 4, 5,
+		5, 6, 7, 8,
+	)
+
+	if !SubtractTo(dst, m, n).Equal(r) {
+		t.Fatal("The difference written into the destination is wrong.")
+	}
+
+	if !m.Equal(sequence(3, 4)) {
+		t.Fatal("The operands should not be rewritten.")
+	}
+}
+
+func TestScaleToRewritesOnlyTheView(t *testing.T) {
+	m := sequence(2, 2)
+
+	base := Zeros(3, 3)
+	ScaleTo(base.View(1, 1, 2, 2).(*Matrix), -2, m)
+
+	r := New(3, 3)(
+		0, 0, 0,
+		0, 0, -2,
+		0, -4, -6,
+	)
+
+	if !base.Equal(r) {
+		t.Fatal("The scaled matrix should be written into the view.")
+	}
+
+	if !m.Equal(sequence(2, 2)) {
+		t.Fatal("The operand should not be rewritten.")
+	}
+}
+
+type destinationTest struct {
+	dst *Matrix
+	a   *Matrix
+	b   *Matrix
+}
+
+func TestAddToAllowsOperandAsDestination(t *testing.T) {
+	base := sequence(4, 4)
+
+	// The expected sums are computed on copies of the operands.
+	tests := []*destinationTest{
+		&destinationTest{dst: base, a: base, b: base},
+		&destinationTest{dst: base, a: sequence(4, 4), b: base},
+		&destinationTest{dst: base, a: base.Transpose().(*Matrix), b: sequence(4, 4)},
+		&destinationTest{dst: base.View(0, 0, 3, 3).(*Matrix), a: base.View(1, 1, 3, 3).(*Matrix), b: base.View(0, 1, 3, 3).(*Matrix)},
+	}
+
+	for index, test := range tests {
+		a := Zeros(test.a.Shape()).assign(test.a)
+		b := Zeros(test.b.Shape()).assign(test.b)
+
+		r := a.Add(b)
+
+		if !AddTo(test.dst, test.a, test.b).Equal(r) {
+			t.Fatalf("The sum of the test %d is wrong.", index)
+		}
+	}
+}
+
+func TestAddToCausesPanicForInvalidDestination(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatal("The destination of the wrong shape should cause a panic.")
+	}()
+
+	AddTo(Zeros(3, 3), sequence(3, 2), sequence(3, 2))
+}
+
+func TestAddWritesNonZerosOfOtherTypeIntoView(t *testing.T) {
+	base := Zeros(4, 5)
+
+	n := New(3, 2)(
+		1, 0,
+		0, 0,
+		0, -2,
+	)
+
+	m := base.View(1, 2, 2, 3).Transpose().(*Matrix)
+	m.Add(&nonZerosMatrix{Matrix: n})
+	m.Subtract(&nonZerosMatrix{Matrix: n.Scalar(2).(*Matrix)})
+
+	r := New(4, 5)(
+		0, 0, 0, 0, 0,
+		0, 0, -1, 0, 0,
+		0, 0, 0, 0, 2,
+		0, 0, 0, 0, 0,
+	)
+
+	if base.Equal(r) {
+		return
+	}
+
+	t.Fatal("The non-zero elements of the matrix of another type should be added to the view.")
+}
@@ -0,0 +1,70 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/shape"
+)
+
+// lineCursor iterates elements on a straight line of the matrix.
+// Unlike the other cursors, it walks indexes of the receiver (not of the base),
+// so that the line is kept straight regardless of transposition.
+type lineCursor struct {
+	matrix     *Matrix
+	element    float64
+	current    *shape.Index
+	next       *shape.Index
+	rowStep    int
+	columnStep int
+}
+
+func newDiagonalsCursor(matrix *Matrix, offset int) *lineCursor {
+	start := shape.NewIndex(0, offset)
+	if offset < 0 {
+		start = shape.NewIndex(-offset, 0)
+	}
+
+	c := &lineCursor{
+		matrix:     matrix,
+		element:    0,
+		current:    start,
+		next:       start,
+		rowStep:    1,
+		columnStep: 1,
+	}
+
+	return c
+}
+
+func newAntiDiagonalCursor(matrix *Matrix) *lineCursor {
+	start := shape.NewIndex(0, matrix.Columns()-1)
+
+	c := &lineCursor{
+		matrix:     matrix,
+		element:    0,
+		current:    start,
+		next:       start,
+		rowStep:    1,
+		columnStep: -1,
+	}
+
+	return c
+}
+
+func (c *lineCursor) HasNext() bool {
+	c.current = c.next
+
+	rows, columns := c.matrix.Shape()
+	row, column := c.current.Row(), c.current.Column()
+
+	if row < 0 || row >= rows || column < 0 || column >= columns {
+		return false
+	}
+
+	c.element = c.matrix.Get(row, column)
+	c.next = shape.NewIndex(row+c.rowStep, column+c.columnStep)
+
+	return true
+}
+
+func (c *lineCursor) Get() (element float64, row, column int) {
+	return c.element, c.current.Row(), c.current.Column()
+}
@@ -0,0 +1,82 @@
+package dense
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// "sparseMatrix" declares a dense matrix as sparse, and fails the test when all elements are visited.
+type sparseMatrix struct {
+	*Matrix
+	t *testing.T
+}
+
+func (m *sparseMatrix) Capabilities() types.Capability {
+	return m.Matrix.Capabilities() | types.Sparse
+}
+
+func (m *sparseMatrix) All() types.Cursor {
+	m.t.Fatal("All elements of the sparse matrix should not be visited.")
+
+	return nil
+}
+
+type equalSparseTest struct {
+	m     *Matrix
+	n     *Matrix
+	equal bool
+}
+
+func TestEqualVisitsNonZerosOfSparseMatrix(t *testing.T) {
+	n := New(3, 3)(
+		0, 2, 0,
+		0, 0, 0,
+		-1, 0, 0,
+	)
+
+	tests := []*equalSparseTest{
+		&equalSparseTest{m: New(3, 3)(0, 2, 0, 0, 0, 0, -1, 0, 0), n: n, equal: true},
+		&equalSparseTest{m: New(3, 3)(0, 2, 0, 0, 0, 0, -1, 0, 0).Transpose().Transpose().(*Matrix), n: n, equal: true},
+		&equalSparseTest{m: New(3, 3)(0, 2, 0, 0, 0, 0, 1, 0, 0), n: n, equal: false},
+		&equalSparseTest{m: New(3, 3)(0, 2, 0, 0, 3, 0, -1, 0, 0), n: n, equal: false},
+		&equalSparseTest{m: New(4, 4)(0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, -1, 0, 0).View(1, 1, 3, 3).(*Matrix), n: n, equal: true},
+		&equalSparseTest{m: Zeros(3, 3), n: Zeros(3, 3), equal: true},
+	}
+
+	for index, test := range tests {
+		if test.m.Equal(&sparseMatrix{Matrix: test.n, t: t}) != test.equal {
+			t.Fatalf("The equality of the test %d should be %v.", index, test.equal)
+		}
+	}
+}
+
+// "thresholdSparseMatrix" declares a dense matrix as sparse, and allows all elements to be visited.
+type thresholdSparseMatrix struct {
+	*Matrix
+}
+
+func (m *thresholdSparseMatrix) Capabilities() types.Capability {
+	return m.Matrix.Capabilities() | types.Sparse
+}
+
+func TestEqualComparesElementsWithinZeroThresholdOfSparseMatrix(t *testing.T) {
+	n := New(2, 3)(
+		1e-9, 2, 0,
+		0, 0, -1e-8,
+	)
+	n.SetZeroThreshold(1e-6)
+
+	tests := []*equalSparseTest{
+		&equalSparseTest{m: New(2, 3)(1e-9, 2, 0, 0, 0, -1e-8), n: n, equal: true},
+		&equalSparseTest{m: New(3, 2)(1e-9, 0, 2, 0, 0, -1e-8).Transpose().(*Matrix), n: n, equal: true},
+		&equalSparseTest{m: New(2, 3)(1e-9, 2, 0, 0, 0, 0), n: n, equal: false},
+		&equalSparseTest{m: New(2, 3)(1e-9, 2, 1e-9, 0, 0, -1e-8), n: n, equal: false},
+	}
+
+	for index, test := range tests {
+		if test.m.Equal(&thresholdSparseMatrix{Matrix: test.n}) != test.equal {
+			t.Fatalf("The equality of the test %d should be %v.", index, test.equal)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package dense
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Format the matrix for the verbs of package "fmt".
+// "%v" and "%s" render the matrix as "String" does,
+// and "%f", "%e" and "%g" render each element with the given precision such as "%.3f".
+// "%#v" writes a Go expression which creates the matrix with "dense.New".
+func (m *Matrix) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		if f.Flag('#') {
+			f.Write([]byte(m.goString()))
+			return
+		}
+
+		if precision, ok := f.Precision(); ok {
+			p := NewPrinter()
+			p.Precision = precision
+			f.Write([]byte(p.Sprint(m)))
+			return
+		}
+
+		f.Write([]byte(m.String()))
+	case 'f', 'F', 'e', 'E', 'g', 'G':
+		precision, ok := f.Precision()
+		if !ok {
+			precision = -1
+		}
+
+		format := func(element float64) string {
+			return strconv.FormatFloat(element, byte(verb), precision, 64)
+		}
+
+		f.Write([]byte(NewPrinter().render(m, format)))
+	default:
+		fmt.Fprintf(f, "%%!%c(*dense.Matrix=%s)", verb, m.String())
+	}
+}
+
+// Create a Go expression which creates the matrix with "dense.New".
+func (m *Matrix) goString() string {
+	rows, columns := m.Shape()
+
+	buffer := bytes.NewBuffer([]byte{})
+
+	fmt.Fprintf(buffer, "dense.New(%d, %d)(", rows, columns)
+
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			if row > 0 || column > 0 {
+				buffer.WriteString(", ")
+			}
+
+			buffer.WriteString(goFloat(m.Get(row, column)))
+		}
+	}
+
+	buffer.WriteString(")")
+
+	return buffer.String()
+}
+
+// Convert the element to a Go expression of float64.
+func goFloat(element float64) string {
+	switch {
+	case math.IsNaN(element):
+		return "math.NaN()"
+	case math.IsInf(element, 1):
+		return "math.Inf(1)"
+	case math.IsInf(element, -1):
+		return "math.Inf(-1)"
+	case element == 0 && math.Signbit(element):
+		return "math.Copysign(0, -1)"
+	}
+
+	return strconv.FormatFloat(element, 'g', -1, 64)
+}
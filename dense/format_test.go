@@ -0,0 +1,83 @@
+package dense
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+type formatTest struct {
+	format string
+	matrix *Matrix
+	text   string
+}
+
+func TestFormatSupportsVerbs(t *testing.T) {
+	m := New(2, 2)(
+		1, 0.125,
+		-10, 2,
+	)
+
+	tests := []*formatTest{
+		&formatTest{
+			format: "%v",
+			matrix: m,
+			text:   m.String(),
+		},
+		&formatTest{
+			format: "%s",
+			matrix: m,
+			text:   m.String(),
+		},
+		&formatTest{
+			format: "%.3f",
+			matrix: m,
+			text: "  1.000  0.125\n" +
+				"-10.000  2.000",
+		},
+		&formatTest{
+			format: "%.1v",
+			matrix: m,
+			text: "  1.0  0.1\n" +
+				"-10.0  2.0",
+		},
+		&formatTest{
+			format: "%e",
+			matrix: New(1, 2)(1, 0.5),
+			text:   "1e+00  5e-01",
+		},
+		&formatTest{
+			format: "%#v",
+			matrix: m,
+			text:   "dense.New(2, 2)(1, 0.125, -10, 2)",
+		},
+		&formatTest{
+			format: "%#v",
+			matrix: New(1, 3)(math.NaN(), math.Inf(1), math.Inf(-1)),
+			text:   "dense.New(1, 3)(math.NaN(), math.Inf(1), math.Inf(-1))",
+		},
+		&formatTest{
+			format: "%d",
+			matrix: New(1, 1)(1),
+			text:   "%!d(*dense.Matrix=1)",
+		},
+	}
+
+	for _, test := range tests {
+		if text := fmt.Sprintf(test.format, test.matrix); text != test.text {
+			t.Fatalf("%q should format the matrix as %q, but is %q.", test.format, test.text, text)
+		}
+	}
+}
+
+func TestFormatWritesAllElementsForGoSyntax(t *testing.T) {
+	m := sequence(20, 1)
+
+	text := fmt.Sprintf("%#v", m)
+
+	if text == fmt.Sprintf("dense.New(20, 1)(%s)", "0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19") {
+		return
+	}
+
+	t.Fatalf("The Go syntax should not omit any element, but is %q.", text)
+}
@@ -0,0 +1,77 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/internal/rewriters"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"General" is the row-major representation of a matrix in the layout of "blas64.General" of gonum,
+so that it can be converted with blas64.General(g) and passed to BLAS routines.
+The element at (i, j) is Data[i*Stride+j].
+*/
+type General struct {
+	Rows   int
+	Cols   int
+	Stride int
+	Data   []float64
+}
+
+// Return the row-major representation of the receiver.
+// When the receiver is not transposed, "Data" shares the backing storage with the receiver.
+// Otherwise, the elements are copied in row-major order.
+func (m *Matrix) General() General {
+	rows, columns := m.Shape()
+
+	if m.Order() == ColumnMajor {
+		g := General{
+			Rows:   rows,
+			Cols:   columns,
+			Stride: columns,
+			Data:   m.loadRowMajor(),
+		}
+
+		return g
+	}
+
+	begin, _ := m.rowRange(0)
+	_, end := m.rowRange(rows - 1)
+
+	g := General{
+		Rows:   rows,
+		Cols:   columns,
+		Stride: m.base.Columns(),
+		Data:   m.elements[begin:end],
+	}
+
+	return g
+}
+
+// Create a new matrix from the row-major representation.
+// When "Data" holds "Rows" x "Stride" elements, the matrix shares it as a view.
+// Otherwise, the elements are copied.
+// When "Rows" or "Cols" is not positive, validates.NON_POSITIVE_SIZE_PANIC will be caused,
+// and when "Stride" is less than "Cols" or "Data" is too short,
+// validates.INVALID_ELEMENTS_PANIC will be caused.
+func NewFromGeneral(g General) *Matrix {
+	validates.ShapeShouldBePositive(g.Rows, g.Cols)
+	validates.ShapeShouldNotOverflow(g.Rows, g.Stride)
+
+	if g.Stride < g.Cols || len(g.Data) < (g.Rows-1)*g.Stride+g.Cols {
+		panic(validates.INVALID_ELEMENTS_PANIC)
+	}
+
+	if len(g.Data) >= g.Rows*g.Stride {
+		m := &Matrix{}
+		m.initializeCompact(g.Rows, g.Stride, g.Data[:g.Rows*g.Stride], rewriters.Reflect(), 0)
+
+		return m.View(0, 0, g.Rows, g.Cols).(*Matrix)
+	}
+
+	elements := make([]float64, 0, g.Rows*g.Cols)
+	for row := 0; row < g.Rows; row++ {
+		elements = append(elements, g.Data[row*g.Stride:row*g.Stride+g.Cols]...)
+	}
+
+	return New(g.Rows, g.Cols)(elements...)
+}
@@ -0,0 +1,74 @@
+package dense
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestGeneralSharesTheBackingStorage(t *testing.T) {
+	m := sequence(4, 5).View(1, 2, 2, 3).(*Matrix)
+
+	g := m.General()
+
+	if g.Rows != 2 || g.Cols != 3 || g.Stride != 5 {
+		t.Fatalf("The general should be 2x3 with the stride 5, but is %dx%d with %d.", g.Rows, g.Cols, g.Stride)
+	}
+
+	for i := 0; i < g.Rows; i++ {
+		for j := 0; j < g.Cols; j++ {
+			if g.Data[i*g.Stride+j] != m.Get(i, j) {
+				t.Fatalf("The element at (%d, %d) of the general is wrong.", i, j)
+			}
+		}
+	}
+
+	g.Data[0] = -1
+
+	if m.Get(0, 0) == -1 {
+		return
+	}
+
+	t.Fatal("The general should share the backing storage with the matrix.")
+}
+
+func TestGeneralCopiesTransposedMatrix(t *testing.T) {
+	m := sequence(3, 2).Transpose().(*Matrix)
+
+	g := m.General()
+
+	if g.Rows != 2 || g.Cols != 3 || g.Stride != 3 {
+		t.Fatalf("The general should be 2x3 with the stride 3, but is %dx%d with %d.", g.Rows, g.Cols, g.Stride)
+	}
+
+	if NewFromGeneral(g).Equal(m) {
+		return
+	}
+
+	t.Fatal("The general of the transposed matrix is wrong.")
+}
+
+func TestNewFromGeneralReturnsTheMatrix(t *testing.T) {
+	tests := []General{
+		General{Rows: 2, Cols: 2, Stride: 3, Data: []float64{0, 1, 9, 3, 4, 9}},
+		General{Rows: 2, Cols: 2, Stride: 3, Data: []float64{0, 1, 9, 3, 4}},
+	}
+
+	for _, g := range tests {
+		if !NewFromGeneral(g).Equal(New(2, 2)(0, 1, 3, 4)) {
+			t.Fatalf("The matrix created from %d elements is wrong.", len(g.Data))
+		}
+	}
+}
+
+func TestNewFromGeneralCausesPanicForShortData(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.INVALID_ELEMENTS_PANIC {
+			return
+		}
+
+		t.Fatalf("The short data should cause %s.", validates.INVALID_ELEMENTS_PANIC)
+	}()
+
+	NewFromGeneral(General{Rows: 2, Cols: 2, Stride: 3, Data: []float64{0, 1, 9, 3}})
+}
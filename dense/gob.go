@@ -0,0 +1,108 @@
+package dense
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+
+	"github.com/mitsuse/matrix-go/internal/rewriters"
+	"github.com/mitsuse/matrix-go/shape"
+)
+
+const (
+	// The format version of "GobEncode", which is independent from the one of JSON.
+	gobVersion = 0
+)
+
+/*
+"matrixGob" is the representation of matrix for encoding/gob.
+Only the elements in the view are kept in the order of the backing storage.
+*/
+type matrixGob struct {
+	Version   int
+	Rows      int
+	Columns   int
+	Elements  []float64
+	Rewriter  byte
+	Threshold float64
+}
+
+func (m *Matrix) GobEncode() ([]byte, error) {
+	gobObject := &matrixGob{
+		Version:   gobVersion,
+		Rows:      m.view.Rows(),
+		Columns:   m.view.Columns(),
+		Elements:  m.viewElements(),
+		Rewriter:  m.rewriter.Type(),
+		Threshold: m.threshold,
+	}
+
+	buffer := &bytes.Buffer{}
+
+	if err := gob.NewEncoder(buffer).Encode(gobObject); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (m *Matrix) GobDecode(b []byte) error {
+	if m.initialized {
+		return errors.New(AlreadyInitializedError)
+	}
+
+	gobObject := &matrixGob{}
+
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(gobObject); err != nil {
+		return err
+	}
+
+	if gobObject.Version != gobVersion {
+		return &UnsupportedVersionError{Version: gobObject.Version}
+	}
+
+	if err := CheckShape(gobObject.Rows, gobObject.Columns); err != nil {
+		return err
+	}
+
+	if len(gobObject.Elements) != gobObject.Rows*gobObject.Columns {
+		return errors.New(InvalidElementsError)
+	}
+
+	if err := checkThreshold(gobObject.Threshold); err != nil {
+		return err
+	}
+
+	rewriter, err := rewriters.Get(gobObject.Rewriter)
+	if err != nil {
+		return err
+	}
+
+	m.initializeCompact(gobObject.Rows, gobObject.Columns, gobObject.Elements, rewriter, gobObject.Threshold)
+
+	return nil
+}
+
+// Copy the elements in the view in the order of the backing storage.
+func (m *Matrix) viewElements() []float64 {
+	elements := make([]float64, 0, m.view.Rows()*m.view.Columns())
+
+	for row := 0; row < m.view.Rows(); row++ {
+		begin, end := m.rowRange(row)
+		elements = append(elements, m.elements[begin:end]...)
+	}
+
+	return elements
+}
+
+// Initialize the receiver with the elements copied by "viewElements".
+func (m *Matrix) initializeCompact(rows, columns int, elements []float64, rewriter rewriters.Rewriter, threshold float64) {
+	m.base = shape.NewShape(rows, columns)
+	m.view = m.base
+	m.offset = shape.NewIndex(0, 0)
+	m.elements = elements
+	m.rewriter = rewriter
+	m.threshold = threshold
+
+	m.initialized = true
+}
@@ -0,0 +1,91 @@
+package dense
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"testing"
+)
+
+func TestGobEncodeAndDecodeReturnsTheOriginal(t *testing.T) {
+	matrices := []*Matrix{
+		New(2, 3)(
+			0, 1, 2,
+			3, 4, 5,
+		),
+		sequence(5, 6).View(1, 2, 3, 4).(*Matrix),
+		sequence(5, 6).View(1, 2, 3, 4).Transpose().(*Matrix),
+		sequence(2, 2).SetZeroThreshold(0.5).(*Matrix),
+	}
+
+	for _, m := range matrices {
+		buffer := &bytes.Buffer{}
+
+		if err := gob.NewEncoder(buffer).Encode(m); err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		n := &Matrix{}
+
+		if err := gob.NewDecoder(buffer).Decode(n); err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if !n.Equal(m) || n.Order() != m.Order() || n.ZeroThreshold() != m.ZeroThreshold() {
+			t.Fatal("The decoded matrix should equal to the original.")
+		}
+
+		if rows, columns := n.Shape(); rows*columns != len(n.elements) {
+			t.Fatal("Only the elements in the view should be encoded.")
+		}
+	}
+}
+
+func TestGobDecodeFailsForInitializedMatrix(t *testing.T) {
+	b, err := New(1, 1)(1).GobEncode()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if err := Zeros(1, 1).GobDecode(b); err != nil && err.Error() == AlreadyInitializedError {
+		return
+	}
+
+	t.Fatalf("The initialized matrix should cause %s.", AlreadyInitializedError)
+}
+
+func TestGobDecodeFailsForInvalidElements(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	gob.NewEncoder(buffer).Encode(&matrixGob{Rows: 2, Columns: 2, Elements: []float64{1, 2, 3}})
+
+	if err := (&Matrix{}).GobDecode(buffer.Bytes()); err != nil && err.Error() == InvalidElementsError {
+		return
+	}
+
+	t.Fatalf("The wrong number of elements should cause %s.", InvalidElementsError)
+}
+
+func TestGobDecodeFailsForInvalidThreshold(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	gob.NewEncoder(buffer).Encode(&matrixGob{Rows: 1, Columns: 2, Elements: []float64{1, 2}, Threshold: math.NaN()})
+
+	if err := (&Matrix{}).GobDecode(buffer.Bytes()); err != nil && err.Error() == InvalidThresholdError {
+		return
+	}
+
+	t.Fatalf("The NaN threshold should cause %s.", InvalidThresholdError)
+}
+
+func TestGobDecodeFailsForUnsupportedVersion(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	gob.NewEncoder(buffer).Encode(&matrixGob{Version: gobVersion + 1, Rows: 1, Columns: 1, Elements: []float64{1}})
+
+	if _, ok := (&Matrix{}).GobDecode(buffer.Bytes()).(*UnsupportedVersionError); ok {
+		return
+	}
+
+	t.Fatal("The version newer than the gob format should cause *UnsupportedVersionError.")
+}
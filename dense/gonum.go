@@ -0,0 +1,55 @@
+//go:build gonum && !cblas
+// +build gonum,!cblas
+
+package dense
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+func init() {
+	SetEngine(gonumEngine{})
+}
+
+/*
+"gonumEngine" runs the kernels with blas64 of gonum, and solves equations with "GoEngine".
+*/
+type gonumEngine struct {
+	GoEngine
+}
+
+func (e gonumEngine) Name() string {
+	return "gonum"
+}
+
+func (e gonumEngine) Multiply(transA, transB bool, rows, inner, columns int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) {
+	tA, x := gonumOperand(transA, rows, inner, a, lda)
+	tB, y := gonumOperand(transB, inner, columns, b, ldb)
+
+	blas64.Gemm(
+		tA, tB, 1, x, y, 0,
+		blas64.General{Rows: rows, Cols: columns, Stride: ldc, Data: c},
+	)
+}
+
+// Return the operand of "rows" x "columns" after the transposition as stored in "elements".
+func gonumOperand(trans bool, rows, columns int, elements []float64, stride int) (blas.Transpose, blas64.General) {
+	if trans {
+		return blas.Trans, blas64.General{Rows: columns, Cols: rows, Stride: stride, Data: elements}
+	}
+
+	return blas.NoTrans, blas64.General{Rows: rows, Cols: columns, Stride: stride, Data: elements}
+}
+
+func (e gonumEngine) Add(alpha float64, x, y []float64) {
+	blas64.Axpy(
+		alpha,
+		blas64.Vector{N: len(x), Data: x, Inc: 1},
+		blas64.Vector{N: len(x), Data: y, Inc: 1},
+	)
+}
+
+func (e gonumEngine) Scale(alpha float64, x []float64) {
+	blas64.Scal(alpha, blas64.Vector{N: len(x), Data: x, Inc: 1})
+}
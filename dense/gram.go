@@ -0,0 +1,49 @@
+package dense
+
+const (
+	// The number of rows and columns of the tiles of the product with the transpose computed at once.
+	gramTileSize = 256
+)
+
+// Check whether "n" is the transpose of the receiver sharing the same elements,
+// as "m.Transpose()" is.
+func (m *Matrix) isTransposeOf(n *Matrix) bool {
+	return m.rewriter != n.rewriter &&
+		m.sharesElements(n) &&
+		m.base.Rows() == n.base.Rows() &&
+		m.base.Columns() == n.base.Columns() &&
+		m.view.Rows() == n.view.Rows() &&
+		m.view.Columns() == n.view.Columns() &&
+		m.offset.Row() == n.offset.Row() &&
+		m.offset.Column() == n.offset.Column()
+}
+
+// Create the product of the receiver and its transpose.
+// Only the tiles on and above the diagonal are computed with "Multiply" of the engine,
+// and the upper triangle is mirrored to the lower one, so the result is exactly symmetric.
+func (m *Matrix) multiplyTranspose() *Matrix {
+	rows, columns := m.Shape()
+	r := Zeros(rows, rows)
+
+	t := m.Transpose().(*Matrix)
+
+	for i0 := 0; i0 < rows; i0 += gramTileSize {
+		i1 := minInt(i0+gramTileSize, rows)
+		a := m.View(i0, 0, i1-i0, columns).(*Matrix)
+
+		for j0 := i0; j0 < rows; j0 += gramTileSize {
+			j1 := minInt(j0+gramTileSize, rows)
+			b := t.View(0, j0, columns, j1-j0).(*Matrix)
+
+			multiplyInto(r.elements[i0*rows+j0:], rows, a, b)
+		}
+	}
+
+	for i := 0; i < rows; i++ {
+		for j := i + 1; j < rows; j++ {
+			r.elements[j*rows+i] = r.elements[i*rows+j]
+		}
+	}
+
+	return r
+}
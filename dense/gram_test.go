@@ -0,0 +1,69 @@
+package dense
+
+import (
+	"testing"
+)
+
+// Check whether "m" equals to its transpose exactly.
+func isSymmetric(m *Matrix) bool {
+	for row := 0; row < m.Rows(); row++ {
+		for column := 0; column < row; column++ {
+			if m.Get(row, column) != m.Get(column, row) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func TestMultiplyTransposeReturnsSymmetricMatrix(t *testing.T) {
+	a := sequence(9, 7).View(1, 2, 6, 4).(*Matrix)
+	a.Apply(func(element float64) float64 { return 1 / (element + 0.3) })
+
+	// The product of "b" and its transpose consists of several tiles.
+	b := sequence(600, 9).View(2, 1, 530, 7).(*Matrix)
+	b.Apply(func(element float64) float64 { return 1 / (element + 0.3) })
+
+	matrices := []struct {
+		m *Matrix
+		n *Matrix
+	}{
+		{m: a, n: a.Transpose().(*Matrix)},
+		{m: a.Transpose().(*Matrix), n: a},
+		{m: b, n: b.Transpose().(*Matrix)},
+		{m: b.Transpose().(*Matrix), n: b},
+	}
+
+	for _, test := range matrices {
+		r := test.m.Multiply(test.n).(*Matrix)
+
+		// The copy shares no elements with "test.m", so the generic product is computed.
+		s := test.m.Multiply(test.m.TransposeCopy()).(*Matrix)
+
+		if rows, columns := r.Shape(); rows != test.m.Rows() || columns != test.m.Rows() {
+			t.Fatalf("The shape should be %dx%d, but is %dx%d.", test.m.Rows(), test.m.Rows(), rows, columns)
+		}
+
+		if !isSymmetric(r) {
+			t.Fatal("The product of a matrix and its transpose should be exactly symmetric.")
+		}
+
+		if !r.EqualApprox(s, 1e-12) {
+			t.Fatal("The product of a matrix and its transpose is wrong.")
+		}
+	}
+}
+
+func TestMultiplyTransposeOfDifferentViewIsGeneric(t *testing.T) {
+	m := sequence(4, 4)
+
+	r := m.View(0, 0, 2, 4).Multiply(m.View(0, 0, 2, 4).Transpose().View(0, 1, 4, 1))
+	s := m.View(0, 0, 2, 4).Multiply(m.View(1, 0, 1, 4).(*Matrix).TransposeCopy())
+
+	if r.Equal(s) {
+		return
+	}
+
+	t.Fatal("The product with a different view should be computed generically.")
+}
@@ -0,0 +1,169 @@
+package dense
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+
+	"github.com/mitsuse/matrix-go/internal/rewriters"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	InvalidIndexError = "InvalidIndexError"
+)
+
+const (
+	// The first bytes of files written by "SerializeIndexed".
+	IndexedMagic = "MTXINDX1"
+
+	// The size of the magic, the rows, the columns and "blockRows".
+	indexedHeaderSize = len(IndexedMagic) + 3*8
+)
+
+// Serialize "m" in the format which can be read row by row with "DeserializeRow".
+// The format starts with the magic "MTXINDX1", the rows, the columns and "blockRows",
+// followed by the index of offsets to the blocks of "blockRows" rows from the beginning,
+// and the blocks of elements in row-major order.
+// All numbers are little-endian, and integers are uint64.
+// When "blockRows" is not positive, validates.NON_POSITIVE_SIZE_PANIC will be caused.
+func SerializeIndexed(writer io.Writer, m types.Matrix, blockRows int) error {
+	validates.ShapeShouldBePositive(blockRows, 1)
+
+	rows, columns := m.Shape()
+	blocks := (rows + blockRows - 1) / blockRows
+
+	w := bufio.NewWriter(writer)
+	w.WriteString(IndexedMagic)
+
+	if err := binary.Write(w, binary.LittleEndian, []uint64{uint64(rows), uint64(columns), uint64(blockRows)}); err != nil {
+		return err
+	}
+
+	index := make([]uint64, blocks)
+	offset := uint64(indexedHeaderSize + 8*blocks)
+
+	for block := range index {
+		index[block] = offset
+		offset += uint64(8 * blockRows * columns)
+	}
+
+	if err := binary.Write(w, binary.LittleEndian, index); err != nil {
+		return err
+	}
+
+	row := make([]float64, columns)
+
+	for i := 0; i < rows; i++ {
+		for column := range row {
+			row[column] = m.Get(i, column)
+		}
+
+		if err := binary.Write(w, binary.LittleEndian, row); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+/*
+"IndexedReader" reads rows of a matrix written by "SerializeIndexed"
+without reading the other rows.
+*/
+type IndexedReader struct {
+	reader    io.ReaderAt
+	rows      int
+	columns   int
+	blockRows int
+}
+
+// Create a new reader of rows, reading and validating the header.
+// When the header is malformed, InvalidIndexError is returned.
+func NewIndexedReader(reader io.ReaderAt) (*IndexedReader, error) {
+	header := make([]byte, indexedHeaderSize)
+	if n, _ := reader.ReadAt(header, 0); n < len(header) || string(header[:len(IndexedMagic)]) != IndexedMagic {
+		return nil, errors.New(InvalidIndexError)
+	}
+
+	values := make([]uint64, 3)
+	for index := range values {
+		values[index] = binary.LittleEndian.Uint64(header[len(IndexedMagic)+8*index:])
+
+		if values[index] == 0 || values[index] > math.MaxInt32 {
+			return nil, errors.New(InvalidIndexError)
+		}
+	}
+
+	rows, columns := int(values[0]), int(values[1])
+
+	if err := CheckShape(rows, columns); err != nil {
+		return nil, err
+	}
+
+	r := &IndexedReader{
+		reader:    reader,
+		rows:      rows,
+		columns:   columns,
+		blockRows: int(values[2]),
+	}
+
+	return r, nil
+}
+
+// Return the shape of the whole matrix.
+func (r *IndexedReader) Shape() (rows, columns int) {
+	return r.rows, r.columns
+}
+
+// Read the row as a new 1 x columns matrix.
+// Only the entry of the index and the elements of the row are read.
+// When "row" is out of range, validates.OUT_OF_RANGE_PANIC will be caused,
+// and when the index or the row is broken, InvalidIndexError is returned.
+func (r *IndexedReader) Row(row int) (*Matrix, error) {
+	validates.IndexShouldBeInRange(r.rows, 1, row, 0)
+
+	entry := make([]byte, 8)
+	if n, _ := r.reader.ReadAt(entry, int64(indexedHeaderSize+8*(row/r.blockRows))); n < len(entry) {
+		return nil, errors.New(InvalidIndexError)
+	}
+
+	size := uint64(8 * r.columns)
+
+	// The block is bounded so that the offset of the row fits in int64.
+	block := binary.LittleEndian.Uint64(entry)
+	if block > math.MaxInt64/2 {
+		return nil, errors.New(InvalidIndexError)
+	}
+
+	offset := block + uint64(row%r.blockRows)*size
+
+	b := make([]byte, size)
+	if n, _ := r.reader.ReadAt(b, int64(offset)); n < len(b) {
+		return nil, errors.New(InvalidIndexError)
+	}
+
+	elements := make([]float64, r.columns)
+	for column := range elements {
+		elements[column] = math.Float64frombits(binary.LittleEndian.Uint64(b[8*column:]))
+	}
+
+	m := &Matrix{}
+	m.initializeCompact(1, r.columns, elements, rewriters.Reflect(), 0)
+
+	return m, nil
+}
+
+// Read a row of the matrix written by "SerializeIndexed" as a new 1 x columns matrix.
+// This reads the header every time, so use "IndexedReader" to read many rows.
+func DeserializeRow(reader io.ReaderAt, row int) (*Matrix, error) {
+	r, err := NewIndexedReader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Row(row)
+}
@@ -0,0 +1,141 @@
+package dense
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// A reader recording the number of bytes read.
+type countingReaderAt struct {
+	reader *bytes.Reader
+	read   int
+}
+
+func (r *countingReaderAt) ReadAt(b []byte, offset int64) (int, error) {
+	n, err := r.reader.ReadAt(b, offset)
+	r.read += n
+
+	return n, err
+}
+
+func TestDeserializeRowReturnsTheRow(t *testing.T) {
+	matrices := []*Matrix{
+		sequence(1, 1),
+		sequence(7, 5),
+		sequence(20, 30).View(3, 4, 11, 13).Transpose().(*Matrix),
+	}
+
+	for _, m := range matrices {
+		for _, blockRows := range []int{1, 3, 100} {
+			buffer := &bytes.Buffer{}
+
+			if err := SerializeIndexed(buffer, m, blockRows); err != nil {
+				t.Fatalf("An unexpected error %q occurred.", err)
+			}
+
+			reader := bytes.NewReader(buffer.Bytes())
+
+			for row := 0; row < m.Rows(); row++ {
+				n, err := DeserializeRow(reader, row)
+				if err != nil {
+					t.Fatalf("An unexpected error %q occurred.", err)
+				}
+
+				if n.Rows() != 1 || n.Columns() != m.Columns() {
+					t.Fatalf("The row %d should be 1x%d, but is %dx%d.", row, m.Columns(), n.Rows(), n.Columns())
+				}
+
+				for column := 0; column < m.Columns(); column++ {
+					if n.Get(0, column) != m.Get(row, column) {
+						t.Fatalf("The row %d should equal to the original.", row)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestIndexedReaderReadsOnlyTheRow(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	if err := SerializeIndexed(buffer, sequence(1000, 10), 64); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	reader := &countingReaderAt{reader: bytes.NewReader(buffer.Bytes())}
+
+	r, err := NewIndexedReader(reader)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if rows, columns := r.Shape(); rows != 1000 || columns != 10 {
+		t.Fatalf("The shape should be 1000x10, but is %dx%d.", rows, columns)
+	}
+
+	reader.read = 0
+
+	m, err := r.Row(777)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if !m.Equal(sequence(1000, 10).Row(777)) {
+		t.Fatal("The row should equal to the original.")
+	}
+
+	if reader.read != 8+8*10 {
+		t.Fatalf("Only the index entry and the row should be read, but %d bytes were read.", reader.read)
+	}
+}
+
+func TestIndexedReaderRowCausesPanicForOutOfRange(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	if err := SerializeIndexed(buffer, sequence(3, 2), 2); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	r, err := NewIndexedReader(bytes.NewReader(buffer.Bytes()))
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	defer func() {
+		if p := recover(); p == validates.OUT_OF_RANGE_PANIC {
+			return
+		}
+
+		t.Fatalf("The row out of range should cause %s.", validates.OUT_OF_RANGE_PANIC)
+	}()
+
+	r.Row(3)
+}
+
+func TestDeserializeRowFailsForBrokenFile(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	if err := SerializeIndexed(buffer, sequence(4, 4), 2); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	b := buffer.Bytes()
+
+	// The offset of the second block points beyond the end.
+	broken := append([]byte{}, b...)
+	broken[indexedHeaderSize+8+7] = 0x7f
+
+	files := [][]byte{
+		b[:4],
+		b[:len(b)-1],
+		broken,
+	}
+
+	for _, file := range files {
+		if _, err := DeserializeRow(bytes.NewReader(file), 3); err == nil || err.Error() != InvalidIndexError {
+			t.Fatalf("The broken file should cause %s, but %v occurred.", InvalidIndexError, err)
+		}
+	}
+}
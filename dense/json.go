@@ -1,25 +1,146 @@
 package dense
 
 import (
-	"github.com/mitsuse/matrix-go/internal/types"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"math"
+
+	"github.com/mitsuse/matrix-go/shape"
 )
 
+/*
+The serialized matrix is a JSON object with the following keys:
+
+	version   the format version
+	base      the shape of the backing storage as {"rows", "columns"}
+	view      the shape of the view in the storage coordinates
+	offset    the index of the view in the storage coordinates as {"rows", "columns"}
+	elements  all elements of the backing storage in row-major order, until version 1
+	data      the base64 of IEEE-754 bits of "elements" in little-endian, since version 2
+	rewriter  the type of index rewriter, 0 for identity and 1 for transpose
+	threshold the zero threshold, since version 1
+	checksum  the CRC-32 of the other keys except "version", since version 3
+
+Version 0 has no "threshold", and the threshold of a matrix read from it is 0.
+Version 2 replaces "elements" with "data",
+which keeps every float64 including NaN and infinities exactly.
+Version 3 adds "checksum" to detect truncated or modified data.
+*/
 const (
-	version    = 0
+	version    = 3
 	minVersion = 0
-	maxVersion = 0
+	maxVersion = 3
 )
 
 const (
 	AlreadyInitializedError  = "AlreadyInitializedError"
 	IncompatibleVersionError = "IncompatibleVersion"
+	ChecksumMismatchError    = "ChecksumMismatchError"
 )
 
 type matrixJson struct {
 	Version  int          `json:"version"`
-	Base     *types.Shape `json:"base"`
-	View     *types.Shape `json:"view"`
-	Offset   *types.Index `json:"offset"`
-	Elements []float64    `json:"elements"`
+	Base     *shape.Shape `json:"base"`
+	View     *shape.Shape `json:"view"`
+	Offset   *shape.Index `json:"offset"`
+	Elements []float64    `json:"elements,omitempty"`
+	Data     []byte       `json:"data,omitempty"`
 	Rewriter byte         `json:"rewriter"`
+
+	Threshold *float64 `json:"threshold,omitempty"`
+	Checksum  *uint32  `json:"checksum,omitempty"`
+}
+
+// Compute the CRC-32 of the shapes, the offset, the rewriter, the threshold and the data.
+// The numbers are written in little-endian as uint64, and the rewriter as a byte.
+func (j *matrixJson) checksum() uint32 {
+	var threshold float64
+	if j.Threshold != nil {
+		threshold = *j.Threshold
+	}
+
+	header := []uint64{
+		uint64(j.Base.Rows()),
+		uint64(j.Base.Columns()),
+		uint64(j.View.Rows()),
+		uint64(j.View.Columns()),
+		uint64(j.Offset.Row()),
+		uint64(j.Offset.Column()),
+		math.Float64bits(threshold),
+	}
+
+	b := make([]byte, 8*len(header), 8*len(header)+1+len(j.Data))
+	for i, value := range header {
+		binary.LittleEndian.PutUint64(b[8*i:], value)
+	}
+
+	b = append(b, j.Rewriter)
+	b = append(b, j.Data...)
+
+	return crc32.ChecksumIEEE(b)
+}
+
+/*
+"UnsupportedVersionError" is returned when a matrix is serialized or deserialized in a format version
+out of "SupportedVersions".
+Its message is IncompatibleVersionError.
+*/
+type UnsupportedVersionError struct {
+	Version int
+}
+
+func (e *UnsupportedVersionError) Error() string {
+	return IncompatibleVersionError
+}
+
+/*
+"CorruptedDataError" is returned when the checksum of a deserialized matrix doesn't match its contents,
+which happens when the data is truncated or modified,
+and when the data is inconsistent in the formats without checksums.
+Its message is ChecksumMismatchError, and the checksums are kept in the fields if any.
+*/
+type CorruptedDataError struct {
+	Expected uint32
+	Actual   uint32
+}
+
+func (e *CorruptedDataError) Error() string {
+	return ChecksumMismatchError
+}
+
+// Check whether the non-empty "view" at "offset" is in "base",
+// without overflowing int for offsets and views of untrusted data.
+func isViewInBase(base, view *shape.Shape, offset *shape.Index) bool {
+	return 0 <= offset.Row() && offset.Row() < base.Rows() &&
+		0 <= offset.Column() && offset.Column() < base.Columns() &&
+		0 < view.Rows() && view.Rows() <= base.Rows()-offset.Row() &&
+		0 < view.Columns() && view.Columns() <= base.Columns()-offset.Column()
+}
+
+// Encode "elements" as IEEE-754 bits in little-endian.
+func encodeBits(elements []float64) []byte {
+	b := make([]byte, 8*len(elements))
+
+	for i, element := range elements {
+		binary.LittleEndian.PutUint64(b[8*i:], math.Float64bits(element))
+	}
+
+	return b
+}
+
+// Decode elements encoded by "encodeBits".
+// When the length is not a multiple of 8, InvalidElementsError is returned.
+func decodeBits(b []byte) ([]float64, error) {
+	if len(b)%8 != 0 {
+		return nil, errors.New(InvalidElementsError)
+	}
+
+	elements := make([]float64, len(b)/8)
+
+	for i := range elements {
+		elements[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[8*i:]))
+	}
+
+	return elements, nil
 }
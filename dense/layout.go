@@ -0,0 +1,70 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/internal/rewriters"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+/*
+"Order" is the order in which the elements of matrix are laid out in the backing storage.
+*/
+type Order int
+
+const (
+	RowMajor Order = iota
+	ColumnMajor
+)
+
+// Return the storage order of the receiver.
+// The transpose of a row-major matrix is column-major.
+func (m *Matrix) Order() Order {
+	if m.rewriter == rewriters.Reverse() {
+		return ColumnMajor
+	}
+
+	return RowMajor
+}
+
+// Check whether the receiver is the transpose of the backing storage, that is, it is column-major.
+func (m *Matrix) IsTransposed() bool {
+	return m.Order() == ColumnMajor
+}
+
+// Check whether the receiver is a view of a part of the backing storage.
+// Views of the whole storage, such as the transpose, are not regarded as such.
+func (m *Matrix) IsView() bool {
+	rows, columns := m.base.Rows(), m.base.Columns()
+
+	return m.view.Rows() != rows || m.view.Columns() != columns
+}
+
+// Return the distances in the backing storage between elements adjacent in a row and a column.
+func (m *Matrix) Strides() (row, column int) {
+	if m.Order() == ColumnMajor {
+		return 1, m.base.Columns()
+	}
+
+	return m.base.Columns(), 1
+}
+
+// Check whether the elements of the receiver occupy a contiguous range of the backing storage.
+func (m *Matrix) IsContiguous() bool {
+	return m.view.Rows() == 1 || m.view.Columns() == m.base.Columns()
+}
+
+// Return the capabilities of the receiver.
+// Dense matrices are mutable and share the backing storage with views,
+// are contiguous when "IsContiguous" is true, and are transposed when "IsTransposed" is true.
+func (m *Matrix) Capabilities() types.Capability {
+	c := types.Mutable | types.Views
+
+	if m.IsContiguous() {
+		c |= types.Contiguous
+	}
+
+	if m.IsTransposed() {
+		c |= types.Transposed
+	}
+
+	return c
+}
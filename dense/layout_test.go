@@ -0,0 +1,89 @@
+package dense
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/types"
+)
+
+func TestOrderIsRowMajor(t *testing.T) {
+	if Zeros(2, 3).Order() == RowMajor {
+		return
+	}
+
+	t.Fatal("The order of a new matrix should be row-major.")
+}
+
+func TestOrderOfTransposeIsColumnMajor(t *testing.T) {
+	if Zeros(2, 3).Transpose().(*Matrix).Order() == ColumnMajor {
+		return
+	}
+
+	t.Fatal("The order of a transpose matrix should be column-major.")
+}
+
+func TestStridesFollowTheOrder(t *testing.T) {
+	m := Zeros(4, 3).View(1, 1, 2, 2).(*Matrix)
+
+	if row, column := m.Strides(); row != 3 || column != 1 {
+		t.Fatalf("The strides should be (3, 1), but are (%d, %d).", row, column)
+	}
+
+	if row, column := m.Transpose().(*Matrix).Strides(); row != 1 || column != 3 {
+		t.Fatalf("The strides should be (1, 3), but are (%d, %d).", row, column)
+	}
+}
+
+func TestIsContiguous(t *testing.T) {
+	m := Zeros(4, 3)
+
+	if !m.IsContiguous() || !m.View(1, 0, 2, 3).(*Matrix).IsContiguous() || !m.Row(2).(*Matrix).IsContiguous() {
+		t.Fatal("The matrix, views of full rows and row views should be contiguous.")
+	}
+
+	if m.View(0, 0, 2, 2).(*Matrix).IsContiguous() || m.Column(1).(*Matrix).IsContiguous() {
+		t.Fatal("Views of partial rows should not be contiguous.")
+	}
+}
+
+func TestCapabilitiesOfDense(t *testing.T) {
+	m := Zeros(4, 3)
+
+	if c := m.Capabilities(); !c.Has(types.Mutable|types.Views|types.Contiguous) || c.Has(types.Sparse) || c.Has(types.ThreadSafe) {
+		t.Fatal("A new dense matrix should be mutable, contiguous and support views.")
+	}
+
+	if c := m.View(1, 1, 2, 2).Capabilities(); c.Has(types.Contiguous) || !c.Has(types.Mutable|types.Views) {
+		t.Fatal("A view cutting rows should not be contiguous.")
+	}
+}
+
+func TestIsTransposed(t *testing.T) {
+	m := Zeros(4, 3)
+
+	if m.IsTransposed() || m.View(1, 1, 2, 2).(*Matrix).IsTransposed() || m.Capabilities().Has(types.Transposed) {
+		t.Fatal("A new matrix and its views should not be transposed.")
+	}
+
+	if n := m.Transpose(); !n.(*Matrix).IsTransposed() || !n.Capabilities().Has(types.Transposed) {
+		t.Fatal("The transpose should be transposed.")
+	}
+
+	if m.Transpose().Transpose().(*Matrix).IsTransposed() {
+		t.Fatal("The transpose of the transpose should not be transposed.")
+	}
+}
+
+func TestIsView(t *testing.T) {
+	m := Zeros(4, 3)
+
+	if m.IsView() || m.Transpose().(*Matrix).IsView() {
+		t.Fatal("A new matrix and its transpose should not be views.")
+	}
+
+	if m.View(1, 0, 2, 3).(*Matrix).IsView() && m.Row(0).Transpose().(*Matrix).IsView() {
+		return
+	}
+
+	t.Fatal("Views of parts of the matrix should be views.")
+}
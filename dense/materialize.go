@@ -0,0 +1,88 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+const (
+	// The largest number of elements in a block copied directly by "TransposeCopy".
+	transposeLeafSize = 256
+)
+
+// Transpose the elements of the receiver in the backing storage.
+// Unlike "Transpose", the receiver keeps its storage order,
+// so sequential scans of the result stay as fast as those of the original.
+// When the receiver is not square, validates.NOT_SQUARE_PANIC will be caused.
+func (m *Matrix) TransposeInPlace() *Matrix {
+	validates.ShapeShouldBeSquare(m)
+
+	size := m.view.Rows()
+	begin, _ := m.rowRange(0)
+	stride := m.base.Columns()
+
+	for tileRow := 0; tileRow < size; tileRow += tileSize {
+		rowEnd := minInt(tileRow+tileSize, size)
+
+		for tileColumn := tileRow; tileColumn < size; tileColumn += tileSize {
+			columnEnd := minInt(tileColumn+tileSize, size)
+
+			for row := tileRow; row < rowEnd; row++ {
+				column := tileColumn
+				if tileColumn == tileRow {
+					column = row + 1
+				}
+
+				for ; column < columnEnd; column++ {
+					i := begin + row*stride + column
+					j := begin + column*stride + row
+
+					m.elements[i], m.elements[j] = m.elements[j], m.elements[i]
+				}
+			}
+		}
+	}
+
+	return m
+}
+
+// Create a new row-major matrix which is the transpose of the receiver.
+// The elements are copied recursively by halves of blocks,
+// which keeps the accesses local for any size of cache.
+func (m *Matrix) TransposeCopy() *Matrix {
+	rows, columns := m.Shape()
+	n := Zeros(columns, rows)
+
+	m.transposeBlock(n, 0, 0, rows, columns)
+
+	return n
+}
+
+// Copy the block of the receiver at ("row", "column") to the transposed position of "n".
+func (m *Matrix) transposeBlock(n *Matrix, row, column, rows, columns int) {
+	if rows*columns <= transposeLeafSize {
+		begin, _ := m.rowRange(0)
+		rowStride, columnStride := m.Strides()
+
+		for r := row; r < row+rows; r++ {
+			for c := column; c < column+columns; c++ {
+				n.elements[c*n.base.Columns()+r] = m.elements[begin+r*rowStride+c*columnStride]
+			}
+		}
+
+		return
+	}
+
+	if rows >= columns {
+		half := rows / 2
+
+		m.transposeBlock(n, row, column, half, columns)
+		m.transposeBlock(n, row+half, column, rows-half, columns)
+
+		return
+	}
+
+	half := columns / 2
+
+	m.transposeBlock(n, row, column, rows, half)
+	m.transposeBlock(n, row, column+half, rows, columns-half)
+}
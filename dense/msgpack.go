@@ -0,0 +1,147 @@
+package dense
+
+import (
+	"errors"
+
+	"github.com/mitsuse/matrix-go/internal/msgpack"
+	"github.com/mitsuse/matrix-go/internal/rewriters"
+)
+
+const (
+	// The format version of "MarshalMsgpack", which is independent from the one of JSON.
+	msgpackVersion = 0
+)
+
+// Encode the receiver as MessagePack map with the keys
+// "version", "rows", "columns", "rewriter", "threshold" and "elements".
+// As in "GobEncode", only the elements in the view are kept in the order of the backing storage.
+func (m *Matrix) MarshalMsgpack() ([]byte, error) {
+	elements := m.viewElements()
+
+	w := msgpack.NewWriter(64 + 9*len(elements))
+
+	w.WriteMapHeader(6)
+
+	w.WriteString("version")
+	w.WriteInt(msgpackVersion)
+
+	w.WriteString("rows")
+	w.WriteInt(int64(m.view.Rows()))
+
+	w.WriteString("columns")
+	w.WriteInt(int64(m.view.Columns()))
+
+	w.WriteString("rewriter")
+	w.WriteInt(int64(m.rewriter.Type()))
+
+	w.WriteString("threshold")
+	w.WriteFloat64(m.threshold)
+
+	w.WriteString("elements")
+	w.WriteArrayHeader(len(elements))
+
+	for _, element := range elements {
+		w.WriteFloat64(element)
+	}
+
+	return w.Bytes(), nil
+}
+
+// Decode the receiver from MessagePack encoded by "MarshalMsgpack".
+func (m *Matrix) UnmarshalMsgpack(b []byte) error {
+	if m.initialized {
+		return errors.New(AlreadyInitializedError)
+	}
+
+	r := msgpack.NewReader(b)
+
+	size, err := r.ReadMapHeader()
+	if err != nil {
+		return err
+	}
+
+	var (
+		v, rows, columns, rewriterType int64
+		threshold                      float64
+		elements                       []float64
+	)
+
+	for i := 0; i < size; i++ {
+		key, err := r.ReadString()
+		if err != nil {
+			return err
+		}
+
+		switch key {
+		case "version":
+			v, err = r.ReadInt()
+		case "rows":
+			rows, err = r.ReadInt()
+		case "columns":
+			columns, err = r.ReadInt()
+		case "rewriter":
+			rewriterType, err = r.ReadInt()
+		case "threshold":
+			threshold, err = r.ReadFloat64()
+		case "elements":
+			elements, err = readElements(r)
+		default:
+			return errors.New(InvalidElementsError)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if v != msgpackVersion {
+		return &UnsupportedVersionError{Version: int(v)}
+	}
+
+	if err := CheckShape(int(rows), int(columns)); err != nil {
+		return err
+	}
+
+	if int64(len(elements)) != rows*columns || r.Len() != 0 {
+		return errors.New(InvalidElementsError)
+	}
+
+	if err := checkThreshold(threshold); err != nil {
+		return err
+	}
+
+	if rewriterType < 0 || 255 < rewriterType {
+		return errors.New(InvalidElementsError)
+	}
+
+	rewriter, err := rewriters.Get(byte(rewriterType))
+	if err != nil {
+		return err
+	}
+
+	m.initializeCompact(int(rows), int(columns), elements, rewriter, threshold)
+
+	return nil
+}
+
+func readElements(r *msgpack.Reader) ([]float64, error) {
+	size, err := r.ReadArrayHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	// Every element occupies 5 bytes at least, which bounds the allocation for broken input.
+	if size > r.Len()/5 {
+		return nil, errors.New(InvalidElementsError)
+	}
+
+	elements := make([]float64, size)
+
+	for i := range elements {
+		if elements[i], err = r.ReadFloat64(); err != nil {
+			return nil, err
+		}
+	}
+
+	return elements, nil
+}
@@ -0,0 +1,87 @@
+package dense
+
+import (
+	"testing"
+)
+
+func TestMarshalMsgpackAndUnmarshalMsgpackReturnsTheOriginal(t *testing.T) {
+	matrices := []*Matrix{
+		New(2, 3)(
+			0, -1, 2.5,
+			3, 4, 5e100,
+		),
+		sequence(20, 30).View(1, 2, 17, 19).(*Matrix),
+		sequence(20, 30).View(1, 2, 17, 19).Transpose().(*Matrix),
+		sequence(2, 2).SetZeroThreshold(0.5).(*Matrix),
+	}
+
+	for _, m := range matrices {
+		b, err := m.MarshalMsgpack()
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		n := &Matrix{}
+
+		if err := n.UnmarshalMsgpack(b); err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if !n.Equal(m) || n.Order() != m.Order() || n.ZeroThreshold() != m.ZeroThreshold() {
+			t.Fatal("The decoded matrix should equal to the original.")
+		}
+	}
+}
+
+func TestMarshalMsgpackReturnsMap(t *testing.T) {
+	b, err := New(1, 1)(1).MarshalMsgpack()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	// A fixmap of 6 entries starting with the fixstr "version" and the fixint of the current version.
+	prefix := "\x86\xa7version" + string(rune(msgpackVersion))
+
+	if len(b) > len(prefix) && string(b[:len(prefix)]) == prefix {
+		return
+	}
+
+	t.Fatalf("The encoded matrix should start with %q, but is %q.", prefix, b)
+}
+
+func TestUnmarshalMsgpackFailsForBrokenInput(t *testing.T) {
+	b, err := New(2, 2)(1, 2, 3, 4).MarshalMsgpack()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	for size := 0; size < len(b); size++ {
+		if err := (&Matrix{}).UnmarshalMsgpack(b[:size]); err == nil {
+			t.Fatalf("The truncated input of %d bytes should fail.", size)
+		}
+	}
+
+	if err := (&Matrix{}).UnmarshalMsgpack(append(b, 0)); err == nil || err.Error() != InvalidElementsError {
+		t.Fatalf("The trailing bytes should cause %s.", InvalidElementsError)
+	}
+
+	if err := Zeros(1, 1).UnmarshalMsgpack(b); err == nil || err.Error() != AlreadyInitializedError {
+		t.Fatalf("The initialized matrix should cause %s.", AlreadyInitializedError)
+	}
+}
+
+func TestUnmarshalMsgpackFailsForUnsupportedVersion(t *testing.T) {
+	b, err := New(1, 1)(1).MarshalMsgpack()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	// The fixint of the version follows the fixmap header and the fixstr "version".
+	b[len("\x86\xa7version")] = msgpackVersion + 1
+
+	if _, ok := (&Matrix{}).UnmarshalMsgpack(b).(*UnsupportedVersionError); ok {
+		return
+	}
+
+	t.Fatal("The version newer than the MessagePack format should cause *UnsupportedVersionError.")
+}
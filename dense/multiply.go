@@ -0,0 +1,202 @@
+package dense
+
+import (
+	"sync/atomic"
+
+	"github.com/mitsuse/matrix-go/internal/kernels"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/internal/workspace"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	// The default number of rows and columns of blocks multiplied at once by "multiplyBlockedKernel".
+	defaultBlockSize = 64
+)
+
+var blockSize int64 = defaultBlockSize
+
+// Return the number of rows and columns of blocks multiplied at once by "Multiply" in pure Go.
+// For more details, refer "SetBlockSize".
+func BlockSize() int {
+	return int(atomic.LoadInt64(&blockSize))
+}
+
+// Set the number of rows and columns of blocks multiplied at once by "Multiply" in pure Go, which is 64 by default.
+// Blocks small enough to stay in cache make multiplication faster, and the best size depends on the CPU.
+// The size is not used when the BLAS backend is enabled with the tag "cblas" or "gonum".
+// When "size" is not positive, validates.NON_POSITIVE_SIZE_PANIC will be caused.
+func SetBlockSize(size int) {
+	validates.ShapeShouldBePositive(size, 1)
+
+	atomic.StoreInt64(&blockSize, int64(size))
+}
+
+// Write the product of "a" and "b" into "dst", and return "dst".
+// When "a" and "b" are dense matrices which do not share the backing storage with "dst",
+// the product is written without allocating a new result matrix.
+// "a" and "b" are not rewritten even if they are mutable.
+// When "a" and "b" are not multipliable, validates.NOT_MULTIPLIABLE_PANIC will be caused,
+// and when "dst" is not a.Rows() x b.Columns(), validates.DIFFERENT_SIZE_PANIC will be caused.
+func MultiplyTo(dst *Matrix, a, b types.Matrix) *Matrix {
+	validates.ShapeShouldBeMultipliable(a, b)
+
+	rows, columns := a.Rows(), b.Columns()
+
+	if dst.Rows() != rows || dst.Columns() != columns {
+		panic(validates.DIFFERENT_SIZE_PANIC)
+	}
+
+	m, isDense := a.(*Matrix)
+	n, isAlsoDense := b.(*Matrix)
+
+	if !isDense || !isAlsoDense || dst.Order() != RowMajor || dst.sharesElements(m) || dst.sharesElements(n) {
+		return dst.assign(a.Multiply(b))
+	}
+
+	for i := 0; i < rows; i++ {
+		begin, end := dst.rowRange(i)
+		for index := begin; index < end; index++ {
+			dst.elements[index] = 0
+		}
+	}
+
+	begin, _ := dst.rowRange(0)
+	multiplyInto(dst.elements[begin:], dst.base.Columns(), m, n)
+
+	return dst
+}
+
+// Create the product of the receiver and "n" on their backing slices with "Multiply" of the engine.
+func (m *Matrix) multiplyBlocked(n *Matrix) *Matrix {
+	columns := n.Columns()
+
+	r := Zeros(m.Rows(), columns)
+	multiplyInto(r.elements, columns, m, n)
+
+	return r
+}
+
+// Create the product of the receiver and "n" of another type, such as a sparse matrix,
+// visiting the non-zero elements of "n" only once.
+// The product is accumulated as its transpose on the workspace,
+// so that each non-zero element adds a column of the receiver to a row with "Add" of the engine.
+func (m *Matrix) multiplyNonZeros(n types.Matrix) *Matrix {
+	rows, inner := m.Shape()
+	columns := n.Columns()
+
+	// The row "j" of "a" is the column "j" of the receiver.
+	a := m.Transpose().(*Matrix).loadRowMajorTo(workspace.Get(inner*rows), rows)
+	t := workspace.Get(columns * rows)
+
+	e := Engine()
+	cursor := n.NonZeros()
+
+	for cursor.HasNext() {
+		element, j, k := cursor.Get()
+		e.Add(element, a[j*rows:(j+1)*rows], t[k*rows:(k+1)*rows])
+	}
+
+	r := NewFromGeneral(General{Rows: columns, Cols: rows, Stride: rows, Data: t}).TransposeCopy()
+
+	workspace.Put(a)
+	workspace.Put(t)
+
+	return r
+}
+
+// Compute the product of "m" and "n" into "c" initialized with zeros, whose stride of rows is "ldc".
+// Transposed operands are read from their backing slices with the swapped strides.
+func multiplyInto(c []float64, ldc int, m, n *Matrix) {
+	a, lda, transA := m.multiplyOperand()
+	b, ldb, transB := n.multiplyOperand()
+
+	Engine().Multiply(transA, transB, m.Rows(), m.Columns(), n.Columns(), a, lda, b, ldb, c, ldc)
+}
+
+// Return the backing slice of the receiver for "Multiply" of the engine, the stride of rows,
+// and whether the elements are those of the transpose of the receiver.
+func (m *Matrix) multiplyOperand() (elements []float64, stride int, transposed bool) {
+	begin, _ := m.rowRange(0)
+	_, end := m.rowRange(m.view.Rows() - 1)
+
+	return m.elements[begin:end], m.base.Columns(), m.Order() == ColumnMajor
+}
+
+// Compute "c" = op("a") op("b") in pure Go, where "c" is initialized with zeros,
+// and op(x) is the transpose of "x" when the corresponding flag is true and "x" itself otherwise.
+// The element at (i, j) of "a" is a[i*lda+j], and so are those of "b" and "c".
+// Blocks of "BlockSize" rows and columns of op("b") are multiplied at once so that they stay in cache,
+// and those of the transposed "b" are copied into a tile in row-major order beforehand.
+func multiplyBlockedKernel(transA, transB bool, rows, inner, columns int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) {
+	// The element at (i, k) of op("a") is a[i*rowStride+k*innerStride].
+	rowStride, innerStride := lda, 1
+	if transA {
+		rowStride, innerStride = 1, lda
+	}
+
+	size := BlockSize()
+
+	var tile []float64
+	if transB {
+		tile = workspace.Get(size * size)
+		defer workspace.Put(tile)
+	}
+
+	for j0 := 0; j0 < columns; j0 += size {
+		j1 := minInt(j0+size, columns)
+
+		for k0 := 0; k0 < inner; k0 += size {
+			k1 := minInt(k0+size, inner)
+
+			// The row "k" of the block of op("b") is y[(k-origin)*yStride+yBegin:][:j1-j0].
+			y, yStride, origin, yBegin := b, ldb, 0, j0
+
+			if transB {
+				for k := k0; k < k1; k++ {
+					for j := j0; j < j1; j++ {
+						tile[(k-k0)*size+j-j0] = b[j*ldb+k]
+					}
+				}
+
+				y, yStride, origin, yBegin = tile, size, k0, 0
+			}
+
+			for i := 0; i < rows; i++ {
+				ci := c[i*ldc+j0 : i*ldc+j1]
+
+				for k := k0; k < k1; k++ {
+					x := a[i*rowStride+k*innerStride]
+					if x == 0 {
+						continue
+					}
+
+					begin := (k-origin)*yStride + yBegin
+					kernels.Axpy(x, y[begin:begin+j1-j0], ci)
+				}
+			}
+		}
+	}
+}
+
+// Copy the elements in the view into a new slice in row-major order.
+func (m *Matrix) loadRowMajor() []float64 {
+	return m.loadRowMajorTo(make([]float64, m.Rows()*m.Columns()), m.Columns())
+}
+
+// Copy the elements in the view into "elements" in row-major order as "loadRowMajor", and return "elements".
+// The element at (i, j) is written to elements[i*stride+j].
+func (m *Matrix) loadRowMajorTo(elements []float64, stride int) []float64 {
+	rows, columns := m.Shape()
+
+	begin, _ := m.rowRange(0)
+	rowStride, columnStride := m.Strides()
+
+	for i := 0; i < rows; i++ {
+		for j := 0; j < columns; j++ {
+			elements[i*stride+j] = m.elements[begin+i*rowStride+j*columnStride]
+		}
+	}
+
+	return elements
+}
@@ -0,0 +1,182 @@
+package dense
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestMultiplyBlockedReturnsTheProduct(t *testing.T) {
+	tests := [][2]*Matrix{
+		{sequence(3, 5), sequence(5, 2)},
+		{sequence(70, 130), sequence(130, 65)},
+		{sequence(150, 90).View(7, 3, 80, 70).(*Matrix), sequence(90, 100).View(1, 20, 70, 66).(*Matrix)},
+		{sequence(100, 70).Transpose().(*Matrix), sequence(65, 100).Transpose().(*Matrix)},
+	}
+
+	for _, test := range tests {
+		m, n := test[0], test[1]
+
+		if !m.multiplyBlocked(n).Equal(multiplySlowly(m, n)) {
+			t.Fatalf("The product of %dx%d and %dx%d matrices is wrong.", m.Rows(), m.Columns(), n.Rows(), n.Columns())
+		}
+
+		if !m.Multiply(n).Equal(multiplySlowly(m, n)) {
+			t.Fatalf("Multiply of %dx%d and %dx%d matrices is wrong.", m.Rows(), m.Columns(), n.Rows(), n.Columns())
+		}
+	}
+}
+
+func TestMultiplyBlockedReadsTransposedOperandsInPlace(t *testing.T) {
+	m := sequence(90, 70).View(3, 5, 80, 60).Transpose().(*Matrix)
+	n := sequence(100, 90).View(10, 2, 75, 80).Transpose().(*Matrix)
+
+	tests := [][2]*Matrix{
+		{m, sequence(80, 70)},
+		{sequence(70, 80), n},
+		{m, n},
+	}
+
+	for _, test := range tests {
+		m, n := test[0], test[1]
+
+		rows, inner := m.Shape()
+		columns := n.Columns()
+
+		// The product of the copies in row-major order should be identical.
+		r := Zeros(rows, columns)
+		multiplyBlockedKernel(false, false, rows, inner, columns, m.loadRowMajor(), inner, n.loadRowMajor(), columns, r.elements, columns)
+
+		if !m.multiplyBlocked(n).Equal(r) || !r.Equal(multiplySlowly(m, n)) {
+			t.Fatalf("The product of %v and %v orders is wrong.", m.Order(), n.Order())
+		}
+	}
+}
+
+func TestMultiplyBlockedIgnoresZeroThreshold(t *testing.T) {
+	m := New(2, 3)(
+		1, 2, 0.25,
+		3, 4, 5,
+	)
+	m.SetZeroThreshold(0.5)
+
+	n := New(3, 1)(
+		1,
+		0.25,
+		1,
+	)
+	n.SetZeroThreshold(0.5)
+
+	if m.multiplyBlocked(n).Equal(New(2, 1)(1.75, 9)) {
+		return
+	}
+
+	t.Fatal("The elements within the zero threshold should be multiplied as they are.")
+}
+
+func TestMultiplyToWritesTheProductIntoDestination(t *testing.T) {
+	tests := [][2]*Matrix{
+		{sequence(3, 5), sequence(5, 2)},
+		{sequence(70, 130), sequence(130, 65)},
+		{sequence(40, 30).View(2, 3, 20, 10).(*Matrix), sequence(30, 40).View(1, 5, 10, 7).(*Matrix)},
+		{sequence(10, 20).Transpose().(*Matrix), sequence(10, 20)},
+	}
+
+	for _, test := range tests {
+		m, n := test[0], test[1]
+
+		dst := sequence(m.Rows(), n.Columns())
+
+		if r := MultiplyTo(dst, m, n); r != dst || !r.Equal(multiplySlowly(m, n)) {
+			t.Fatalf("The product of %dx%d and %dx%d matrices is wrong.", m.Rows(), m.Columns(), n.Rows(), n.Columns())
+		}
+	}
+}
+
+func TestMultiplyToWritesIntoView(t *testing.T) {
+	m := sequence(4, 3)
+	n := sequence(3, 2)
+
+	base := Zeros(6, 5)
+	MultiplyTo(base.View(1, 2, 4, 2).(*Matrix), m, n)
+
+	r := multiplySlowly(m, n)
+
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 5; j++ {
+			expected := 0.0
+			if 1 <= i && i < 5 && 2 <= j && j < 4 {
+				expected = r.Get(i-1, j-2)
+			}
+
+			if base.Get(i, j) != expected {
+				t.Fatalf("The element at (%d, %d) should be %v, but is %v.", i, j, expected, base.Get(i, j))
+			}
+		}
+	}
+}
+
+func TestMultiplyToAllowsOperandAsDestination(t *testing.T) {
+	m := sequence(3, 3)
+	n := sequence(3, 3)
+
+	r := multiplySlowly(m, n)
+
+	if MultiplyTo(m, m, n).Equal(r) {
+		return
+	}
+
+	t.Fatal("The product should be correct even if the destination is an operand.")
+}
+
+func TestMultiplyToCausesPanicForInvalidDestination(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatal("The destination of the wrong shape should cause a panic.")
+	}()
+
+	MultiplyTo(Zeros(3, 3), sequence(3, 2), sequence(2, 2))
+}
+
+// "nonZerosMatrix" hides the type of a dense matrix so that it is multiplied as a matrix of another type.
+type nonZerosMatrix struct {
+	*Matrix
+}
+
+func TestMultiplyVisitsNonZerosOfOtherType(t *testing.T) {
+	n := New(3, 2)(
+		0, 2,
+		0, 0,
+		-1, 0,
+	)
+
+	tests := []*Matrix{
+		sequence(4, 3),
+		sequence(5, 6).View(1, 2, 4, 3).(*Matrix),
+		sequence(3, 4).Transpose().(*Matrix),
+	}
+
+	for _, m := range tests {
+		if !m.Multiply(&nonZerosMatrix{Matrix: n}).Equal(multiplySlowly(m, n)) {
+			t.Fatalf("The product of %v order and the matrix of another type is wrong.", m.Order())
+		}
+	}
+}
+
+func TestSetBlockSizeKeepsTheProduct(t *testing.T) {
+	defer SetBlockSize(BlockSize())
+
+	m := sequence(30, 20)
+	n := sequence(25, 20).Transpose().(*Matrix)
+
+	for _, size := range []int{1, 7, 64} {
+		SetBlockSize(size)
+
+		if !m.multiplyBlocked(n).Equal(multiplySlowly(m, n)) {
+			t.Fatalf("The product should be same for the block size %d.", size)
+		}
+	}
+}
@@ -1,15 +1,17 @@
 package dense
 
 import (
-	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/types"
 )
 
 type nonZerosCursor struct {
+	matrix *Matrix
 	cursor types.Cursor
 }
 
 func newNonZerosCursor(matrix *Matrix) *nonZerosCursor {
 	c := &nonZerosCursor{
+		matrix: matrix,
 		cursor: matrix.All(),
 	}
 
@@ -18,7 +20,7 @@ func newNonZerosCursor(matrix *Matrix) *nonZerosCursor {
 
 func (c *nonZerosCursor) HasNext() bool {
 	for c.cursor.HasNext() {
-		if element, _, _ := c.cursor.Get(); element != 0 {
+		if element, _, _ := c.cursor.Get(); !c.matrix.isZero(element) {
 			return true
 		}
 	}
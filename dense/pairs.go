@@ -0,0 +1,83 @@
+package dense
+
+const (
+	// The size of square tiles visited at once when orientations differ.
+	tileSize = 32
+)
+
+// Visit the pairs of backing indexes of "m" and "n" which refer to the same element.
+// When "f" returns false, the visit stops and false is returned.
+// When "m" and "n" have the opposite orientations,
+// the elements are visited tile by tile to keep the accesses to both of them local.
+func (m *Matrix) eachPair(n *Matrix, f func(mIndex, nIndex int) bool) bool {
+	if m.rewriter == n.rewriter {
+		return m.eachAlignedPair(n, f)
+	}
+
+	return m.eachTransposedPair(n, f)
+}
+
+func (m *Matrix) eachAlignedPair(n *Matrix, f func(mIndex, nIndex int) bool) bool {
+	for row := 0; row < m.view.Rows(); row++ {
+		mBegin, mEnd := m.rowRange(row)
+		nBegin, _ := n.rowRange(row)
+
+		for offset := 0; offset < mEnd-mBegin; offset++ {
+			if !f(mBegin+offset, nBegin+offset) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Visit the rows of "m" and "n" with the same orientation as slices of their backing elements.
+func (m *Matrix) eachAlignedRow(n *Matrix, f func(mRow, nRow []float64)) {
+	for row := 0; row < m.view.Rows(); row++ {
+		mBegin, mEnd := m.rowRange(row)
+		nBegin, nEnd := n.rowRange(row)
+
+		f(m.elements[mBegin:mEnd], n.elements[nBegin:nEnd])
+	}
+}
+
+func (m *Matrix) eachTransposedPair(n *Matrix, f func(mIndex, nIndex int) bool) bool {
+	rows, columns := m.view.Rows(), m.view.Columns()
+
+	for tileRow := 0; tileRow < rows; tileRow += tileSize {
+		rowEnd := minInt(tileRow+tileSize, rows)
+
+		for tileColumn := 0; tileColumn < columns; tileColumn += tileSize {
+			columnEnd := minInt(tileColumn+tileSize, columns)
+
+			for row := tileRow; row < rowEnd; row++ {
+				mBegin, _ := m.rowRange(row)
+
+				for column := tileColumn; column < columnEnd; column++ {
+					// The element at (row, column) of the base of "m" is at (column, row) of the base of "n".
+					nBegin, _ := n.rowRange(column)
+
+					if !f(mBegin+column, nBegin+row) {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// Check whether "m" and "n" share the backing elements.
+func (m *Matrix) sharesElements(n *Matrix) bool {
+	return &m.elements[0] == &n.elements[0]
+}
+
+func minInt(x, y int) int {
+	if x < y {
+		return x
+	}
+
+	return y
+}
@@ -0,0 +1,69 @@
+package dense
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Create a "rows x columns" matrix, the elements of which are distinct.
+func sequence(rows, columns int) *Matrix {
+	elements := make([]float64, rows*columns)
+	for index := range elements {
+		elements[index] = float64(index)
+	}
+
+	return New(rows, columns)(elements...)
+}
+
+// Compute the sum with Get and Update to compare with the fast paths.
+func addSlowly(m, n types.Matrix) types.Matrix {
+	r := Zeros(m.Rows(), m.Columns())
+
+	for row := 0; row < m.Rows(); row++ {
+		for column := 0; column < m.Columns(); column++ {
+			r.Update(row, column, m.Get(row, column)+n.Get(row, column))
+		}
+	}
+
+	return r
+}
+
+func TestAddOfTransposeOverTiles(t *testing.T) {
+	m := sequence(40, 70).View(3, 1, 37, 67)
+	n := sequence(80, 50).View(5, 2, 67, 37).Transpose()
+
+	r := addSlowly(m, n)
+
+	if m.Add(n).Equal(r) {
+		return
+	}
+
+	t.Fatal("The addition of matrices with the opposite orientations is wrong.")
+}
+
+func TestSubtractOfTransposeOverTiles(t *testing.T) {
+	m := sequence(40, 35).Transpose()
+	n := sequence(35, 40)
+
+	r := addSlowly(m, n.Scalar(-1))
+	n.Scalar(-1)
+
+	if m.Subtract(n).Equal(r) {
+		return
+	}
+
+	t.Fatal("The subtraction of matrices with the opposite orientations is wrong.")
+}
+
+func TestEqualOfTransposeOverTiles(t *testing.T) {
+	m := sequence(33, 65)
+	n := sequence(33, 65).Transpose().Transpose()
+	n.Update(32, 64, -1)
+
+	if m.Equal(m.Transpose().Transpose()) && !m.Equal(n) {
+		return
+	}
+
+	t.Fatal("The equality of matrices with the opposite orientations is wrong.")
+}
@@ -0,0 +1,79 @@
+package dense
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const (
+	EmptyLiteralError   = "EmptyLiteralError"
+	RaggedLiteralError  = "RaggedLiteralError"
+	InvalidLiteralError = "InvalidLiteralError"
+)
+
+// Create a new matrix from the MATLAB-like literal such as "1 2 3; 4 5 6" or "[1, 2; 3, 4]".
+// Rows are separated by semicolons or newlines, and elements by spaces, tabs or commas.
+// The enclosing brackets are optional, and blank rows are ignored.
+// When no element is found, EmptyLiteralError is returned,
+// when the rows have different numbers of elements, RaggedLiteralError is returned,
+// and when an element is not a number, InvalidLiteralError is returned.
+func Parse(literal string) (*Matrix, error) {
+	literal = strings.TrimSpace(literal)
+
+	if strings.HasPrefix(literal, "[") && strings.HasSuffix(literal, "]") {
+		literal = literal[1 : len(literal)-1]
+	}
+
+	lines := strings.FieldsFunc(literal, func(r rune) bool {
+		return r == ';' || r == '\n'
+	})
+
+	rows := [][]string{}
+
+	for _, line := range lines {
+		fields := strings.FieldsFunc(line, func(r rune) bool {
+			return r == ' ' || r == '\t' || r == ',' || r == '\r'
+		})
+
+		if len(fields) > 0 {
+			rows = append(rows, fields)
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.New(EmptyLiteralError)
+	}
+
+	columns := len(rows[0])
+	elements := make([]float64, 0, len(rows)*columns)
+
+	for _, fields := range rows {
+		if len(fields) != columns {
+			return nil, errors.New(RaggedLiteralError)
+		}
+
+		for _, field := range fields {
+			element, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, errors.New(InvalidLiteralError)
+			}
+
+			elements = append(elements, element)
+		}
+	}
+
+	return New(len(rows), columns)(elements...), nil
+}
+
+// Create a new matrix from the literal as "Parse" does.
+// When the literal is invalid, the error returned by "Parse" is caused as panic.
+// This is intended for tests and examples with literals known to be valid.
+func MustParse(literal string) *Matrix {
+	m, err := Parse(literal)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
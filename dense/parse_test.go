@@ -0,0 +1,86 @@
+package dense
+
+import (
+	"testing"
+)
+
+type parseTest struct {
+	literal string
+	matrix  *Matrix
+}
+
+func TestParseReturnsMatrix(t *testing.T) {
+	tests := []*parseTest{
+		&parseTest{
+			literal: "1 2 3; 4 5 6",
+			matrix: New(2, 3)(
+				1, 2, 3,
+				4, 5, 6,
+			),
+		},
+		&parseTest{
+			literal: " [1, 2; 3, 4] ",
+			matrix: New(2, 2)(
+				1, 2,
+				3, 4,
+			),
+		},
+		&parseTest{
+			literal: "[\n  1.5 -2\n  3e2 0\n]",
+			matrix: New(2, 2)(
+				1.5, -2,
+				300, 0,
+			),
+		},
+		&parseTest{
+			literal: "1; 2; 3",
+			matrix:  New(3, 1)(1, 2, 3),
+		},
+	}
+
+	for _, test := range tests {
+		m, err := Parse(test.literal)
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred for %q.", err, test.literal)
+		}
+
+		if !m.Equal(test.matrix) {
+			t.Fatalf("The matrix parsed from %q is wrong.", test.literal)
+		}
+	}
+}
+
+type parseErrorTest struct {
+	literal string
+	error   string
+}
+
+func TestParseFailsForInvalidLiteral(t *testing.T) {
+	tests := []*parseErrorTest{
+		&parseErrorTest{literal: "", error: EmptyLiteralError},
+		&parseErrorTest{literal: "[]", error: EmptyLiteralError},
+		&parseErrorTest{literal: "1 2; 3", error: RaggedLiteralError},
+		&parseErrorTest{literal: "[1 2", error: InvalidLiteralError},
+		&parseErrorTest{literal: "1 two", error: InvalidLiteralError},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.literal)
+
+		if err == nil || err.Error() != test.error {
+			t.Fatalf("The error %s should occur for %q, but %v occurred.", test.error, test.literal, err)
+		}
+	}
+}
+
+func TestMustParseCausesPanicForInvalidLiteral(t *testing.T) {
+	defer func() {
+		if p, ok := recover().(error); ok && p.Error() == EmptyLiteralError {
+			return
+		}
+
+		t.Fatalf("The invalid literal should cause %s.", EmptyLiteralError)
+	}()
+
+	MustParse(" ")
+}
@@ -0,0 +1,29 @@
+package dense
+
+import (
+	"errors"
+
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+const (
+	NonPositiveSizeError  = "NonPositiveSizeError"
+	SizeOverflowError     = "SizeOverflowError"
+	InvalidElementsError  = "InvalidElementsError"
+	InvalidThresholdError = "InvalidThresholdError"
+)
+
+// Check whether a "rows x columns" matrix can be created without causing panic.
+// When "rows" or "columns" is not positive, NonPositiveSizeError is returned,
+// and when the size of elements in bytes overflows int, SizeOverflowError is returned.
+func CheckShape(rows, columns int) error {
+	if rows <= 0 || columns <= 0 {
+		return errors.New(NonPositiveSizeError)
+	}
+
+	if _, _, ok := validates.CheckedSize(rows, columns, validates.ElementSize); !ok {
+		return errors.New(SizeOverflowError)
+	}
+
+	return nil
+}
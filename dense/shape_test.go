@@ -0,0 +1,100 @@
+package dense
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/internal/rewriters"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/shape"
+)
+
+const maxInt = int(^uint(0) >> 1)
+
+func TestCheckShapeSucceeds(t *testing.T) {
+	if err := CheckShape(3, 2); err != nil {
+		t.Fatalf("The valid shape should not cause any error, but causes %s.", err)
+	}
+}
+
+func TestCheckShapeFailsForNonPositive(t *testing.T) {
+	if err := CheckShape(0, 2); err != nil && err.Error() == NonPositiveSizeError {
+		return
+	}
+
+	t.Fatalf("The non-positive shape should cause %s.", NonPositiveSizeError)
+}
+
+func TestCheckShapeFailsForOverflow(t *testing.T) {
+	if err := CheckShape(maxInt/4, 4); err != nil && err.Error() == SizeOverflowError {
+		return
+	}
+
+	t.Fatalf("The overflowing shape should cause %s.", SizeOverflowError)
+}
+
+func TestZerosFailsForOverflow(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.SIZE_OVERFLOW_PANIC {
+			return
+		}
+
+		t.Fatalf("The overflowing shape should cause %s.", validates.SIZE_OVERFLOW_PANIC)
+	}()
+	Zeros(maxInt/2, 3)
+}
+
+func TestNewFailsForOverflow(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.SIZE_OVERFLOW_PANIC {
+			return
+		}
+
+		t.Fatalf("The overflowing shape should cause %s.", validates.SIZE_OVERFLOW_PANIC)
+	}()
+	New(maxInt/2, 3)
+}
+
+func TestUnmarshalJSONFailsWithOverflowingShape(t *testing.T) {
+	m := &matrixJson{
+		Version:  version,
+		Base:     shape.NewShape(maxInt/2, 3),
+		View:     shape.NewShape(1, 1),
+		Offset:   shape.NewIndex(0, 0),
+		Data:     encodeBits([]float64{0}),
+		Rewriter: rewriters.Reflect().Type(),
+	}
+
+	checksum := m.checksum()
+	m.Checksum = &checksum
+
+	b, _ := json.Marshal(m)
+
+	if err := json.Unmarshal(b, &Matrix{}); err != nil && err.Error() == SizeOverflowError {
+		return
+	}
+
+	t.Fatalf("Unmarshal of the overflowing shape should cause %s.", SizeOverflowError)
+}
+
+func TestUnmarshalJSONFailsWithWrongNumberOfElements(t *testing.T) {
+	m := &matrixJson{
+		Version:  version,
+		Base:     shape.NewShape(2, 2),
+		View:     shape.NewShape(2, 2),
+		Offset:   shape.NewIndex(0, 0),
+		Data:     encodeBits([]float64{0, 1, 2}),
+		Rewriter: rewriters.Reflect().Type(),
+	}
+
+	checksum := m.checksum()
+	m.Checksum = &checksum
+
+	b, _ := json.Marshal(m)
+
+	if err := json.Unmarshal(b, &Matrix{}); err != nil && err.Error() == InvalidElementsError {
+		return
+	}
+
+	t.Fatalf("Unmarshal of the wrong number of elements should cause %s.", InvalidElementsError)
+}
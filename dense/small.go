@@ -0,0 +1,56 @@
+package dense
+
+const (
+	// The largest size of square matrices multiplied by "multiplySmall".
+	smallSize = 4
+)
+
+// Check whether the receiver and "n" are square matrices of the same size small enough for "multiplySmall".
+func (m *Matrix) isSmallWith(n *Matrix) bool {
+	size := m.Rows()
+
+	return 2 <= size && size <= smallSize &&
+		m.Columns() == size &&
+		n.Rows() == size &&
+		n.Columns() == size
+}
+
+// Create the product of the small square receiver and "n",
+// copying both of them into arrays on the stack instead of accessing via "Get" and "Update".
+func (m *Matrix) multiplySmall(n *Matrix) *Matrix {
+	size := m.Rows()
+
+	var a, b [smallSize][smallSize]float64
+
+	m.loadSmall(&a)
+	n.loadSmall(&b)
+
+	r := Zeros(size, size)
+
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			sum := 0.0
+			for k := 0; k < size; k++ {
+				sum += a[i][k] * b[k][j]
+			}
+
+			r.elements[i*size+j] = sum
+		}
+	}
+
+	return r
+}
+
+// Copy the elements into "a".
+func (m *Matrix) loadSmall(a *[smallSize][smallSize]float64) {
+	size := m.Rows()
+
+	begin, _ := m.rowRange(0)
+	rowStride, columnStride := m.Strides()
+
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			a[i][j] = m.elements[begin+i*rowStride+j*columnStride]
+		}
+	}
+}
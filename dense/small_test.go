@@ -0,0 +1,59 @@
+package dense
+
+import (
+	"testing"
+)
+
+// Compute the product with Get and Update to compare with the fast paths.
+func multiplySlowly(m, n *Matrix) *Matrix {
+	r := Zeros(m.Rows(), n.Columns())
+
+	for i := 0; i < m.Rows(); i++ {
+		for j := 0; j < n.Columns(); j++ {
+			for k := 0; k < m.Columns(); k++ {
+				r.Update(i, j, r.Get(i, j)+m.Get(i, k)*n.Get(k, j))
+			}
+		}
+	}
+
+	return r
+}
+
+func TestMultiplySmallReturnsTheProduct(t *testing.T) {
+	for size := 2; size <= smallSize; size++ {
+		m := sequence(5, 6).View(1, 1, size, size).(*Matrix)
+		n := sequence(6, 5).View(0, 1, size, size).Transpose().(*Matrix)
+
+		if !m.isSmallWith(n) {
+			t.Fatalf("The %dx%d matrices should be multiplied by the fast path.", size, size)
+		}
+
+		if !m.Multiply(n).Equal(multiplySlowly(m, n)) {
+			t.Fatalf("The product of %dx%d matrices is wrong.", size, size)
+		}
+	}
+}
+
+func TestMultiplySmallIgnoresZeroThreshold(t *testing.T) {
+	m := New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	n := New(2, 2)(
+		0.25, 1,
+		1, 0.25,
+	)
+	n.SetZeroThreshold(0.5)
+
+	r := New(2, 2)(
+		2.25, 1.5,
+		4.75, 4,
+	)
+
+	if m.Multiply(n).Equal(r) {
+		return
+	}
+
+	t.Fatal("The elements within the zero threshold should be multiplied as they are.")
+}
@@ -0,0 +1,41 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/internal/workspace"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Solve "a" X = "b" with the engine, and return X as a new matrix.
+// Neither "a" nor "b" is rewritten.
+// When "a" is singular, the error of the engine, SingularError for the default one, is returned.
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when the rows of "b" don't equal to the ones of "a",
+// validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func Solve(a, b types.Matrix) (*Matrix, error) {
+	validates.ShapeShouldBeSquare(a)
+	validates.ShapeShouldBeMultipliable(a, b)
+
+	size, columns := a.Rows(), b.Columns()
+
+	elements := workspace.Get(size * size)
+	defer workspace.Put(elements)
+
+	if d, isDense := a.(*Matrix); isDense {
+		d.loadRowMajorTo(elements, size)
+	} else {
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				elements[i*size+j] = a.Get(i, j)
+			}
+		}
+	}
+
+	x := Zeros(size, columns).assign(b)
+
+	if err := Engine().Solve(size, columns, elements, size, x.elements, columns); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
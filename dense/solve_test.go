@@ -0,0 +1,56 @@
+package dense
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSolveReturnsSolution(t *testing.T) {
+	a := New(3, 3)(
+		0, 2, 1,
+		1, 1, 1,
+		2, 1, 3,
+	)
+
+	b := New(3, 2)(
+		5, 1,
+		6, 2,
+		13, 3,
+	)
+
+	x, err := Solve(a, b)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	product := a.Multiply(x)
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 2; j++ {
+			if math.Abs(product.Get(i, j)-b.Get(i, j)) > 1e-9 {
+				t.Fatal("The product of \"a\" and the solution should equal to \"b\".")
+			}
+		}
+	}
+
+	if a.Equal(New(3, 3)(0, 2, 1, 1, 1, 1, 2, 1, 3)) && b.Equal(New(3, 2)(5, 1, 6, 2, 13, 3)) {
+		return
+	}
+
+	t.Fatal("The operands should not be rewritten.")
+}
+
+func TestSolveFailsForSingularMatrix(t *testing.T) {
+	a := New(2, 2)(
+		1, 2,
+		2, 4,
+	)
+
+	_, err := Solve(a, New(2, 1)(1, 2))
+
+	if err != nil && err.Error() == SingularError {
+		return
+	}
+
+	t.Fatalf("The error %s should occur, but %v occurred.", SingularError, err)
+}
@@ -0,0 +1,174 @@
+package dense
+
+import (
+	"sync/atomic"
+
+	"github.com/mitsuse/matrix-go/internal/workspace"
+)
+
+const (
+	// The default size of matrices from which "Multiply" uses the Strassen algorithm.
+	defaultStrassenThreshold = 2048
+)
+
+var strassenThreshold int64 = defaultStrassenThreshold
+
+// Return the size from which "Multiply" uses the Strassen algorithm.
+// For more details, refer "SetStrassenThreshold".
+func StrassenThreshold() int {
+	return int(atomic.LoadInt64(&strassenThreshold))
+}
+
+// Set the size from which "Multiply" uses the Strassen algorithm, which is 2048 by default.
+// Dense matrices are multiplied with the Strassen algorithm
+// when all of the rows and the columns of both operands are not less than "size",
+// and the halves are multiplied recursively until they become smaller than "size".
+// The Strassen algorithm requires less multiplications,
+// but the rounding errors of the result are larger than those of the blocked multiplication.
+// When "size" is not positive, the Strassen algorithm is never used.
+func SetStrassenThreshold(size int) {
+	atomic.StoreInt64(&strassenThreshold, int64(size))
+}
+
+// Check whether the receiver and "n" are large enough for "multiplyStrassen".
+func (m *Matrix) isLargeWith(n *Matrix) bool {
+	threshold := StrassenThreshold()
+	if threshold <= 0 {
+		return false
+	}
+
+	rows, inner := m.Shape()
+	columns := n.Columns()
+
+	return shouldSplit(threshold, rows, inner, columns)
+}
+
+// Check whether matrices of the given sizes are split into halves by the Strassen algorithm.
+func shouldSplit(threshold, rows, inner, columns int) bool {
+	size := minInt(rows, minInt(inner, columns))
+
+	return size >= threshold && size >= 2
+}
+
+// Create the product of the receiver and "n" with the Strassen algorithm.
+// The operands are copied on the workspace with zeros padded,
+// so that they can be split into halves until the halves become smaller than the threshold.
+func (m *Matrix) multiplyStrassen(n *Matrix) *Matrix {
+	threshold := StrassenThreshold()
+
+	rows, inner := m.Shape()
+	columns := n.Columns()
+
+	depth := 0
+	for r, k, c := rows, inner, columns; shouldSplit(threshold, r, k, c); depth++ {
+		r, k, c = half(r), half(k), half(c)
+	}
+
+	pr, pk, pc := pad(rows, depth), pad(inner, depth), pad(columns, depth)
+
+	a := m.loadRowMajorTo(workspace.Get(pr*pk), pk)
+	b := n.loadRowMajorTo(workspace.Get(pk*pc), pc)
+	c := workspace.Get(pr * pc)
+
+	strassen(depth, pr, pk, pc, a, pk, b, pc, c, pc)
+
+	r := Zeros(rows, columns)
+	for i := 0; i < rows; i++ {
+		copy(r.elements[i*columns:(i+1)*columns], c[i*pc:])
+	}
+
+	workspace.Put(a)
+	workspace.Put(b)
+	workspace.Put(c)
+
+	return r
+}
+
+// Return the larger half of "size".
+func half(size int) int {
+	return (size + 1) / 2
+}
+
+// Return the smallest size not less than "size" which can be halved "depth" times.
+func pad(size, depth int) int {
+	unit := 1 << uint(depth)
+
+	return (size + unit - 1) / unit * unit
+}
+
+// Compute "c" = "a" "b" splitting them into halves "depth" times, where "c" is initialized with zeros.
+// The sizes should be divisible by 2 to the power of "depth", and the halves are multiplied by the engine at last.
+// The element at (i, j) of "a" is a[i*lda+j], and so are those of "b" and "c".
+func strassen(depth, rows, inner, columns int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) {
+	e := Engine()
+
+	if depth == 0 {
+		e.Multiply(false, false, rows, inner, columns, a, lda, b, ldb, c, ldc)
+		return
+	}
+
+	r, k, n := rows/2, inner/2, columns/2
+
+	a11, a12, a21, a22 := a, a[k:], a[r*lda:], a[r*lda+k:]
+	b11, b12, b21, b22 := b, b[n:], b[k*ldb:], b[k*ldb+n:]
+	c11, c12, c21, c22 := c, c[n:], c[r*ldc:], c[r*ldc+n:]
+
+	s := workspace.Get(r * k)
+	t := workspace.Get(k * n)
+	p := workspace.Get(r * n)
+
+	// Compute one of the seven products into "p", and add it to the quadrants of "c" with the signs.
+	product := func(x []float64, ldx int, y []float64, ldy int, signs []float64, quadrants ...[]float64) {
+		for index := range p {
+			p[index] = 0
+		}
+
+		strassen(depth-1, r, k, n, x, ldx, y, ldy, p, n)
+
+		for q, quadrant := range quadrants {
+			for i := 0; i < r; i++ {
+				e.Add(signs[q], p[i*n:(i+1)*n], quadrant[i*ldc:i*ldc+n])
+			}
+		}
+	}
+
+	combine(r, k, a11, lda, 1, a22, lda, s)
+	combine(k, n, b11, ldb, 1, b22, ldb, t)
+	product(s, k, t, n, []float64{1, 1}, c11, c22)
+
+	combine(r, k, a21, lda, 1, a22, lda, s)
+	product(s, k, b11, ldb, []float64{1, -1}, c21, c22)
+
+	combine(k, n, b12, ldb, -1, b22, ldb, t)
+	product(a11, lda, t, n, []float64{1, 1}, c12, c22)
+
+	combine(k, n, b21, ldb, -1, b11, ldb, t)
+	product(a22, lda, t, n, []float64{1, 1}, c11, c21)
+
+	combine(r, k, a11, lda, 1, a12, lda, s)
+	product(s, k, b22, ldb, []float64{-1, 1}, c11, c12)
+
+	combine(r, k, a21, lda, -1, a11, lda, s)
+	combine(k, n, b11, ldb, 1, b12, ldb, t)
+	product(s, k, t, n, []float64{1}, c22)
+
+	combine(r, k, a12, lda, -1, a22, lda, s)
+	combine(k, n, b21, ldb, 1, b22, ldb, t)
+	product(s, k, t, n, []float64{1}, c11)
+
+	workspace.Put(s)
+	workspace.Put(t)
+	workspace.Put(p)
+}
+
+// Write "x" + "sign" "y" of "rows" x "columns" into "z", whose stride of rows is "columns".
+func combine(rows, columns int, x []float64, ldx int, sign float64, y []float64, ldy int, z []float64) {
+	e := Engine()
+
+	for i := 0; i < rows; i++ {
+		zi := z[i*columns : (i+1)*columns]
+
+		copy(zi, x[i*ldx:i*ldx+columns])
+		e.Add(sign, y[i*ldy:i*ldy+columns], zi)
+	}
+}
@@ -0,0 +1,61 @@
+package dense
+
+import (
+	"testing"
+)
+
+func TestMultiplyStrassenReturnsTheProduct(t *testing.T) {
+	defer SetStrassenThreshold(StrassenThreshold())
+	SetStrassenThreshold(8)
+
+	tests := [][2]*Matrix{
+		{sequence(16, 16), sequence(16, 16)},
+		{sequence(37, 29), sequence(29, 41)},
+		{sequence(40, 30).View(3, 2, 33, 20).(*Matrix), sequence(50, 40).View(5, 1, 20, 35).(*Matrix)},
+		{sequence(30, 25).Transpose().(*Matrix), sequence(30, 20)},
+	}
+
+	for _, test := range tests {
+		m, n := test[0], test[1]
+
+		if !m.isLargeWith(n) {
+			t.Fatalf("The %dx%d and %dx%d matrices should be multiplied by the Strassen algorithm.", m.Rows(), m.Columns(), n.Rows(), n.Columns())
+		}
+
+		// The elements are integers, so the product is exact.
+		if !m.Multiply(n).Equal(multiplySlowly(m, n)) {
+			t.Fatalf("The product of %dx%d and %dx%d matrices is wrong.", m.Rows(), m.Columns(), n.Rows(), n.Columns())
+		}
+	}
+}
+
+func TestSetStrassenThresholdDisablesStrassen(t *testing.T) {
+	defer SetStrassenThreshold(StrassenThreshold())
+
+	m := sequence(8, 8)
+
+	SetStrassenThreshold(0)
+	if m.isLargeWith(m) {
+		t.Fatal("The Strassen algorithm should be disabled for the non-positive threshold.")
+	}
+
+	SetStrassenThreshold(1)
+	if !m.isLargeWith(m) {
+		t.Fatal("The Strassen algorithm should be used for matrices not smaller than the threshold.")
+	}
+}
+
+func TestPadReturnsMultipleOfPowerOfTwo(t *testing.T) {
+	tests := [][3]int{
+		{37, 0, 37},
+		{37, 1, 38},
+		{37, 3, 40},
+		{40, 3, 40},
+	}
+
+	for _, test := range tests {
+		if size := pad(test[0], test[1]); size != test[2] {
+			t.Fatalf("The padded size of %d for the depth %d should be %d, but is %d.", test[0], test[1], test[2], size)
+		}
+	}
+}
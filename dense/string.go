@@ -0,0 +1,152 @@
+package dense
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// The number of rows and columns shown by the default printer.
+	defaultPrintedRows    = 10
+	defaultPrintedColumns = 10
+)
+
+/*
+"Printer" is a configuration to render a matrix as text with aligned columns.
+*/
+type Printer struct {
+	// The number of digits after the decimal point.
+	// When it is negative, the shortest representation of each element is used.
+	Precision int
+
+	// The largest number of rows to be shown.
+	// When the matrix has more rows, the middle rows are omitted.
+	// When it is not positive, all rows are shown.
+	MaxRows int
+
+	// The largest number of columns to be shown.
+	// When the matrix has more columns, the middle columns are omitted.
+	// When it is not positive, all columns are shown.
+	MaxColumns int
+}
+
+// Create a new printer with the default configuration,
+// which shows the shortest representation of elements in ten rows and ten columns at most.
+func NewPrinter() *Printer {
+	p := &Printer{
+		Precision:  -1,
+		MaxRows:    defaultPrintedRows,
+		MaxColumns: defaultPrintedColumns,
+	}
+
+	return p
+}
+
+// Render the given matrix as text.
+// Each row is written in a line, and elements in the same column are aligned to the right.
+func (p *Printer) Sprint(m *Matrix) string {
+	return p.render(m, p.formatElement)
+}
+
+// Render the given matrix with "format" converting each element to text.
+func (p *Printer) render(m *Matrix, format func(element float64) string) string {
+	rows, columns := m.Shape()
+
+	rowIndices := p.shown(rows, p.MaxRows)
+	columnIndices := p.shown(columns, p.MaxColumns)
+
+	cells := make([][]string, len(rowIndices))
+	widths := make([]int, len(columnIndices))
+
+	for i, row := range rowIndices {
+		cells[i] = make([]string, len(columnIndices))
+
+		for j, column := range columnIndices {
+			var cell string
+			if row < 0 {
+				continue
+			} else if column < 0 {
+				cell = "…"
+			} else {
+				cell = format(m.Get(row, column))
+			}
+
+			cells[i][j] = cell
+
+			if width := utf8.RuneCountInString(cell); widths[j] < width {
+				widths[j] = width
+			}
+		}
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+
+	for i, row := range rowIndices {
+		if i > 0 {
+			buffer.WriteString("\n")
+		}
+
+		if row < 0 {
+			fmt.Fprintf(buffer, "… %d more rows …", rows-len(rowIndices)+1)
+			continue
+		}
+
+		for j, cell := range cells[i] {
+			if j > 0 {
+				buffer.WriteString("  ")
+			}
+
+			buffer.WriteString(strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell)))
+			buffer.WriteString(cell)
+		}
+	}
+
+	return buffer.String()
+}
+
+// Return the indices to be shown out of "size" ones.
+// When "size" exceeds "limit", the middle indices are replaced with a single -1.
+func (p *Printer) shown(size, limit int) []int {
+	if limit <= 0 || size <= limit {
+		indices := make([]int, size)
+		for index := range indices {
+			indices[index] = index
+		}
+
+		return indices
+	}
+
+	head := (limit + 1) / 2
+	tail := limit - head
+
+	indices := make([]int, 0, limit+1)
+
+	for index := 0; index < head; index++ {
+		indices = append(indices, index)
+	}
+
+	indices = append(indices, -1)
+
+	for index := size - tail; index < size; index++ {
+		indices = append(indices, index)
+	}
+
+	return indices
+}
+
+func (p *Printer) formatElement(element float64) string {
+	if p.Precision < 0 {
+		return strconv.FormatFloat(element, 'g', -1, 64)
+	}
+
+	return strconv.FormatFloat(element, 'f', p.Precision, 64)
+}
+
+// Render the matrix as text with the default printer.
+// Use "Printer" to configure the precision and the number of rows and columns to be shown.
+func (m *Matrix) String() string {
+	return NewPrinter().Sprint(m)
+}
@@ -0,0 +1,77 @@
+package dense
+
+import (
+	"strings"
+	"testing"
+)
+
+type printTest struct {
+	printer *Printer
+	matrix  *Matrix
+	text    string
+}
+
+func TestSprintRendersAlignedColumns(t *testing.T) {
+	tests := []*printTest{
+		&printTest{
+			printer: NewPrinter(),
+			matrix: New(2, 3)(
+				1, -2.5, 300,
+				40, 5, -6,
+			),
+			text: " 1  -2.5  300\n" +
+				"40     5   -6",
+		},
+		&printTest{
+			printer: &Printer{Precision: 2},
+			matrix: New(2, 2)(
+				1, 0.125,
+				-10, 2,
+			),
+			text: "  1.00  0.12\n" +
+				"-10.00  2.00",
+		},
+		&printTest{
+			printer: NewPrinter(),
+			matrix: New(2, 3)(
+				1, 2, 3,
+				4, 5, 6,
+			).Transpose().(*Matrix),
+			text: "1  4\n" +
+				"2  5\n" +
+				"3  6",
+		},
+	}
+
+	for _, test := range tests {
+		if text := test.printer.Sprint(test.matrix); text != test.text {
+			t.Fatalf("The matrix should be rendered as %q, but is %q.", test.text, text)
+		}
+	}
+}
+
+func TestSprintOmitsMiddleRowsAndColumns(t *testing.T) {
+	p := &Printer{Precision: -1, MaxRows: 3, MaxColumns: 2}
+
+	text := " 0  …   5\n" +
+		" 6  …  11\n" +
+		"… 3 more rows …\n" +
+		"30  …  35"
+
+	actual := p.Sprint(sequence(6, 6))
+	if actual == text {
+		return
+	}
+
+	t.Fatalf("The matrix should be rendered as %q, but is %q.", text, actual)
+}
+
+func TestStringUsesDefaultPrinter(t *testing.T) {
+	m := sequence(20, 20)
+
+	if m.String() == NewPrinter().Sprint(m) && strings.Contains(m.String(), "… 10 more rows …") {
+		return
+	}
+
+	t.Fatal("String should render the matrix with the default printer.")
+}
@@ -194,6 +194,94 @@ func TestTransposeDiagonalCreatesCursorToIterateDiagonalElements(t *testing.T) {
 	}
 }
 
+func TestTransposeAntiDiagonalCreatesCursorToIterateAntiDiagonalElements(t *testing.T) {
+	m := New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	).Transpose()
+
+	checkTable := [][]bool{
+		[]bool{true, false},
+		[]bool{false, true},
+		[]bool{true, true},
+	}
+
+	cursor := m.AntiDiagonal()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+
+		if e := m.Get(row, column); element != e {
+			t.Fatalf(
+				"The element at (%d, %d) should be %v, but the cursor returns %v.",
+				row,
+				column,
+				e,
+				element,
+			)
+		}
+
+		if checked := checkTable[row][column]; checked {
+			t.Fatalf("Cursor should visit (%d, %d) more than necessary.", row, column)
+		}
+		checkTable[row][column] = true
+	}
+
+	for row, checkRow := range checkTable {
+		for column, checked := range checkRow {
+			if checked {
+				continue
+			}
+
+			t.Fatalf("Cursor didn't visit (%d, %d).", row, column)
+		}
+	}
+}
+
+func TestTransposeDiagonalsCreatesCursorToIterateElementsAboveDiagonal(t *testing.T) {
+	m := New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	).Transpose()
+
+	checkTable := [][]bool{
+		[]bool{true, false},
+		[]bool{true, true},
+		[]bool{true, true},
+	}
+
+	cursor := m.Diagonals(1)
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+
+		if e := m.Get(row, column); element != e {
+			t.Fatalf(
+				"The element at (%d, %d) should be %v, but the cursor returns %v.",
+				row,
+				column,
+				e,
+				element,
+			)
+		}
+
+		if checked := checkTable[row][column]; checked {
+			t.Fatalf("Cursor should visit (%d, %d) more than necessary.", row, column)
+		}
+		checkTable[row][column] = true
+	}
+
+	for row, checkRow := range checkTable {
+		for column, checked := range checkRow {
+			if checked {
+				continue
+			}
+
+			t.Fatalf("Cursor didn't visit (%d, %d).", row, column)
+		}
+	}
+}
+
 func TestTransposeGetFailsByAccessingWithTooLargeRow(t *testing.T) {
 	rows, columns := 8, 6
 	m := Zeros(rows+1, columns+1).View(0, 0, rows, columns).Transpose()
@@ -423,6 +511,25 @@ func TestTransposeEqualIsFalse(t *testing.T) {
 	t.Fatal("The equality of two matrices should be false, but the result is true.")
 }
 
+func TestTransposeEqualIsTrueForTransposeOfTranspose(t *testing.T) {
+	m := New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	).Transpose()
+
+	n := New(3, 2)(
+		0, 3,
+		1, 4,
+		2, 5,
+	).Transpose().Transpose()
+
+	if m.Equal(n) && n.Equal(m) {
+		return
+	}
+
+	t.Fatal("The equality of two matrices should be true regardless of their orientation.")
+}
+
 func TestTransposeEqualCausesPanicForDifferentShapeMatrices(t *testing.T) {
 	m := New(3, 4)(
 		0, 3, 6, 9,
@@ -759,3 +866,75 @@ func TestViewOfTransposeEqualsTransposeOfView(t *testing.T) {
 
 	t.Fatal("The offset and view shape should be transposed when creating on transpose.")
 }
+
+func TestTransposeInPlaceEqualsToTranspose(t *testing.T) {
+	for _, size := range []int{1, 2, 31, 32, 33, 70} {
+		m := sequence(size, size)
+		r := sequence(size, size).Transpose()
+
+		if !m.TransposeInPlace().Equal(r) {
+			t.Fatalf("The in-place transpose of %dx%d matrix is wrong.", size, size)
+		}
+
+		if m.Order() != RowMajor {
+			t.Fatal("The in-place transpose should keep the storage order.")
+		}
+	}
+}
+
+func TestTransposeInPlaceOfViewKeepsTheRest(t *testing.T) {
+	m := sequence(40, 50)
+	r := sequence(40, 50)
+	r.View(2, 5, 36, 36).(*Matrix).Apply(func(float64) float64 { return 0 })
+	r.View(2, 5, 36, 36).Add(sequence(40, 50).View(2, 5, 36, 36).Transpose())
+
+	m.View(2, 5, 36, 36).(*Matrix).TransposeInPlace()
+
+	if m.Equal(r) {
+		return
+	}
+
+	t.Fatal("The in-place transpose should affect only the elements of the view.")
+}
+
+func TestTransposeInPlaceOfTranspose(t *testing.T) {
+	m := sequence(35, 35).Transpose().(*Matrix)
+
+	if m.TransposeInPlace().Equal(sequence(35, 35)) {
+		return
+	}
+
+	t.Fatal("The in-place transpose of the transpose should equal to the original.")
+}
+
+func TestTransposeInPlaceCausesPanicForNonSquare(t *testing.T) {
+	defer func() {
+		if p := recover(); p == nil || p != validates.NOT_SQUARE_PANIC {
+			t.Fatalf("The panic %v should be caused.", validates.NOT_SQUARE_PANIC)
+		}
+	}()
+
+	sequence(2, 3).TransposeInPlace()
+}
+
+func TestTransposeCopyEqualsToTranspose(t *testing.T) {
+	matrices := []*Matrix{
+		sequence(1, 1),
+		sequence(3, 70),
+		sequence(65, 40),
+		sequence(80, 60).View(3, 7, 50, 33).(*Matrix),
+		sequence(80, 60).View(3, 7, 50, 33).Transpose().(*Matrix),
+	}
+
+	for _, m := range matrices {
+		n := m.TransposeCopy()
+
+		if !n.Equal(m.Transpose()) {
+			t.Fatal("The transposed copy should equal to the transpose.")
+		}
+
+		if n.Order() != RowMajor || !n.IsContiguous() {
+			t.Fatal("The transposed copy should be row-major and contiguous.")
+		}
+	}
+}
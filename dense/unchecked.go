@@ -0,0 +1,41 @@
+package dense
+
+import (
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Return the element at ("row", "column") without validating the index.
+// This is intended for inner loops of algorithms which have already validated their bounds.
+// When the index is out of range, the result is undefined:
+// an element outside the view may be returned, or the runtime may panic.
+func (m *Matrix) GetUnchecked(row, column int) (element float64) {
+	return m.elements[m.indexOf(row, column)]
+}
+
+// Rewrite the element at ("row", "column") without validating the index, and return the receiver.
+// As "GetUnchecked", the index should have already been validated by the caller.
+// When the index is out of range, an element outside the view may be rewritten, or the runtime may panic.
+func (m *Matrix) UpdateUnchecked(row, column int, element float64) *Matrix {
+	m.elements[m.indexOf(row, column)] = element
+
+	return m
+}
+
+// Add "alpha" times the non-zero elements of "n" to the receiver,
+// writing into the backing elements directly at the indexes returned by the cursor of "n",
+// which are within the shape of the receiver validated by the caller.
+func (m *Matrix) addNonZeros(alpha float64, n types.Matrix) {
+	cursor := n.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		m.elements[m.indexOf(row, column)] += alpha * element
+	}
+}
+
+// Return the index of the backing element at ("row", "column") without validating it.
+func (m *Matrix) indexOf(row, column int) int {
+	row, column = m.rewriter.Rewrite(row, column)
+
+	return (row+m.offset.Row())*m.base.Columns() + column + m.offset.Column()
+}
@@ -0,0 +1,39 @@
+package dense
+
+import (
+	"testing"
+)
+
+func TestGetUncheckedReturnsSameElementAsGet(t *testing.T) {
+	tests := []*Matrix{
+		sequence(4, 5),
+		sequence(6, 7).View(1, 2, 4, 3).(*Matrix),
+		sequence(6, 7).View(1, 2, 4, 3).Transpose().(*Matrix),
+	}
+
+	for _, m := range tests {
+		rows, columns := m.Shape()
+
+		for i := 0; i < rows; i++ {
+			for j := 0; j < columns; j++ {
+				if m.GetUnchecked(i, j) != m.Get(i, j) {
+					t.Fatalf("The element at (%d, %d) should be %v, but is %v.", i, j, m.Get(i, j), m.GetUnchecked(i, j))
+				}
+			}
+		}
+	}
+}
+
+func TestUpdateUncheckedRewritesSameElementAsUpdate(t *testing.T) {
+	m := Zeros(6, 7)
+	n := Zeros(6, 7)
+
+	m.View(1, 2, 4, 3).Transpose().(*Matrix).UpdateUnchecked(2, 3, 1)
+	n.View(1, 2, 4, 3).Transpose().Update(2, 3, 1)
+
+	if m.Equal(n) {
+		return
+	}
+
+	t.Fatal("The element rewritten by UpdateUnchecked should be the same as Update.")
+}
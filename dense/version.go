@@ -0,0 +1,83 @@
+package dense
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	RoundTripFailedError = "RoundTripFailedError"
+)
+
+// Return the list of format versions which can be written by SerializeVersion
+// and read by Deserialize.
+func SupportedVersions() []int {
+	versions := make([]int, 0, maxVersion-minVersion+1)
+
+	for v := minVersion; v <= maxVersion; v++ {
+		versions = append(versions, v)
+	}
+
+	return versions
+}
+
+// Serialize the matrix "m" in the format of the given "version".
+// When "version" is not supported, *UnsupportedVersionError is returned.
+func SerializeVersion(writer io.Writer, m *Matrix, version int) error {
+	if version < minVersion || maxVersion < version {
+		return &UnsupportedVersionError{Version: version}
+	}
+
+	b, err := m.marshalJSONVersion(version)
+	if err != nil {
+		return err
+	}
+
+	// Terminate with a newline in the same way as (*Matrix).Serialize.
+	if _, err := writer.Write(append(b, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Serialize the matrix "m" in every supported version and deserialize it again.
+// When any deserialized matrix differs from "m" in its base or view,
+// RoundTripFailedError is returned.
+func VerifyRoundTrip(m *Matrix) error {
+	for _, version := range SupportedVersions() {
+		buffer := bytes.NewBuffer([]byte{})
+
+		if err := SerializeVersion(buffer, m, version); err != nil {
+			return err
+		}
+
+		n, err := Deserialize(buffer)
+		if err != nil {
+			return err
+		}
+
+		if !sameShape(m, n) || !m.Equal(n) || !m.Base().Equal(n.Base()) {
+			return errors.New(RoundTripFailedError)
+		}
+	}
+
+	return nil
+}
+
+func sameShape(m, n types.Matrix) bool {
+	mRows, mColumns := m.Shape()
+	nRows, nColumns := n.Shape()
+
+	if mRows != nRows || mColumns != nColumns {
+		return false
+	}
+
+	mRows, mColumns = m.Base().Shape()
+	nRows, nColumns = n.Base().Shape()
+
+	return mRows == nRows && mColumns == nColumns
+}
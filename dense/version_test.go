@@ -0,0 +1,258 @@
+package dense
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/types"
+)
+
+type goldenTest struct {
+	version int
+	path    string
+	matrix  types.Matrix
+}
+
+func goldenTests() []*goldenTest {
+	tests := []*goldenTest{
+		&goldenTest{
+			version: 0,
+			path:    "v0_matrix.json",
+			matrix: New(2, 3)(
+				0, 1.5, -2,
+				3, 4.25, 5,
+			),
+		},
+		&goldenTest{
+			version: 0,
+			path:    "v0_transposed_view.json",
+			matrix: New(3, 3)(
+				1.0, 0.1, 0.9,
+				0.1, 2.5, 0.2,
+				0.2, 0.1, 3.1,
+			).View(1, 1, 2, 1).Transpose(),
+		},
+		&goldenTest{
+			version: 1,
+			path:    "v1_matrix.json",
+			matrix: New(2, 3)(
+				0, 1.5, -2,
+				3, 4.25, 5,
+			).SetZeroThreshold(0.5),
+		},
+		&goldenTest{
+			version: 2,
+			path:    "v2_matrix.json",
+			matrix: New(2, 3)(
+				0, 1.5, -2,
+				3, 4.25, 5,
+			).SetZeroThreshold(0.5),
+		},
+		&goldenTest{
+			version: 3,
+			path:    "v3_matrix.json",
+			matrix: New(2, 3)(
+				0, 1.5, -2,
+				3, 4.25, 5,
+			).SetZeroThreshold(0.5),
+		},
+	}
+
+	return tests
+}
+
+func TestSupportedVersionsContainsTheCurrentVersion(t *testing.T) {
+	for _, v := range SupportedVersions() {
+		if v == version {
+			return
+		}
+	}
+
+	t.Fatalf("The supported versions should contain the current version %d.", version)
+}
+
+func TestSerializeVersionReproducesGoldenFixtures(t *testing.T) {
+	for _, test := range goldenTests() {
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", test.path))
+		if err != nil {
+			t.Fatalf("An expected error occured on reading %s: %s", test.path, err)
+		}
+
+		writer := bytes.NewBuffer([]byte{})
+
+		if err := SerializeVersion(writer, test.matrix.(*Matrix), test.version); err != nil {
+			t.Fatalf("An expected error occured on serialization: %s", err)
+		}
+
+		if !bytes.Equal(writer.Bytes(), expected) {
+			t.Fatalf("The serialized matrix should be identical to %s.", test.path)
+		}
+	}
+}
+
+func TestDeserializeReadsGoldenFixtures(t *testing.T) {
+	for _, test := range goldenTests() {
+		reader, err := os.Open(filepath.Join("testdata", test.path))
+		if err != nil {
+			t.Fatalf("An expected error occured on opening %s: %s", test.path, err)
+		}
+		defer reader.Close()
+
+		n, err := Deserialize(reader)
+		if err != nil {
+			t.Fatalf("An expected error occured on deserialization: %s", err)
+		}
+
+		if !sameShape(test.matrix, n) || !test.matrix.Equal(n) || !test.matrix.Base().Equal(n.Base()) {
+			t.Fatalf("Deserialization failed for %s.", test.path)
+		}
+	}
+}
+
+func TestSerializeVersionFailsForUnsupportedVersion(t *testing.T) {
+	m := Zeros(2, 2)
+
+	writer := bytes.NewBuffer([]byte{})
+
+	if err := SerializeVersion(writer, m, maxVersion+1); err != nil && err.Error() == IncompatibleVersionError {
+		return
+	}
+
+	t.Fatalf("Serialization in an unsupported version should cause %s.", IncompatibleVersionError)
+}
+
+func TestVerifyRoundTripSucceeds(t *testing.T) {
+	for _, test := range goldenTests() {
+		if err := VerifyRoundTrip(test.matrix.(*Matrix)); err != nil {
+			t.Fatalf("The round-trip of %s should succeed, but fails: %s", test.path, err)
+		}
+	}
+}
+
+func TestDeserializeReadsThresholdSinceVersion1(t *testing.T) {
+	for _, test := range goldenTests() {
+		reader, err := os.Open(filepath.Join("testdata", test.path))
+		if err != nil {
+			t.Fatalf("An expected error occured on opening %s: %s", test.path, err)
+		}
+		defer reader.Close()
+
+		n, err := Deserialize(reader)
+		if err != nil {
+			t.Fatalf("An expected error occured on deserialization: %s", err)
+		}
+
+		if n.ZeroThreshold() != test.matrix.ZeroThreshold() {
+			t.Fatalf("The threshold read from %s should be %v.", test.path, test.matrix.ZeroThreshold())
+		}
+	}
+}
+
+func TestSerializeVersionDropsThresholdInVersion0(t *testing.T) {
+	m := Zeros(2, 2).SetZeroThreshold(0.5).(*Matrix)
+
+	writer := bytes.NewBuffer([]byte{})
+
+	if err := SerializeVersion(writer, m, 0); err != nil {
+		t.Fatalf("An expected error occured on serialization: %s", err)
+	}
+
+	n, err := Deserialize(writer)
+	if err != nil {
+		t.Fatalf("An expected error occured on deserialization: %s", err)
+	}
+
+	if n.ZeroThreshold() == 0 {
+		return
+	}
+
+	t.Fatal("The threshold should not be kept in version 0.")
+}
+
+func TestSerializeKeepsEveryFloat64Exactly(t *testing.T) {
+	elements := []float64{
+		0.1,
+		-1.0 / 3,
+		math.Copysign(0, -1),
+		math.SmallestNonzeroFloat64,
+		math.MaxFloat64,
+		math.Inf(1),
+		math.Inf(-1),
+		math.NaN(),
+	}
+
+	m := New(2, 4)(elements...)
+
+	writer := bytes.NewBuffer([]byte{})
+
+	if err := m.Serialize(writer); err != nil {
+		t.Fatalf("An expected error occured on serialization: %s", err)
+	}
+
+	n, err := Deserialize(writer)
+	if err != nil {
+		t.Fatalf("An expected error occured on deserialization: %s", err)
+	}
+
+	for i, element := range elements {
+		if actual := n.Get(i/4, i%4); math.Float64bits(actual) != math.Float64bits(element) {
+			t.Fatalf("The element %v should be kept exactly, but is %v.", element, actual)
+		}
+	}
+}
+
+func TestDeserializeFailsForBrokenData(t *testing.T) {
+	reader := bytes.NewBufferString(`{"version":2,"base":{"rows":1,"columns":1},"view":{"rows":1,"columns":1},"offset":{"rows":0,"columns":0},"data":"AAAA","rewriter":0}`)
+
+	if _, err := Deserialize(reader); err != nil && err.Error() == InvalidElementsError {
+		return
+	}
+
+	t.Fatalf("The data of broken length should cause %s.", InvalidElementsError)
+}
+
+func TestDeserializeReturnsCorruptedDataError(t *testing.T) {
+	fixture, err := ioutil.ReadFile(filepath.Join("testdata", "v3_matrix.json"))
+	if err != nil {
+		t.Fatalf("An expected error occured on reading v3_matrix.json: %s", err)
+	}
+
+	tests := [][]byte{
+		// An element is modified in the data.
+		bytes.Replace(fixture, []byte(`"data":"AAAAAAAAAAAA`), []byte(`"data":"AAAAAAAAAAAB`), 1),
+		// The threshold is modified.
+		bytes.Replace(fixture, []byte(`"threshold":0.5`), []byte(`"threshold":0.6`), 1),
+		// The view is moved.
+		bytes.Replace(fixture, []byte(`"view":{"rows":2,"columns":3}`), []byte(`"view":{"rows":1,"columns":3}`), 1),
+		// The checksum is removed.
+		bytes.Replace(fixture, []byte(`,"checksum"`), []byte(`,"removed"`), 1),
+	}
+
+	for index, test := range tests {
+		if bytes.Equal(test, fixture) {
+			t.Fatalf("The fixture should be modified by the test %d.", index)
+		}
+
+		_, err := Deserialize(bytes.NewReader(test))
+
+		if _, ok := err.(*CorruptedDataError); !ok || err.Error() != ChecksumMismatchError {
+			t.Fatalf("The modified data %d should cause *CorruptedDataError, but %v occurred.", index, err)
+		}
+	}
+}
+
+func TestDeserializeReturnsUnsupportedVersionError(t *testing.T) {
+	reader := bytes.NewBufferString(`{"version":4,"base":{"rows":1,"columns":1},"view":{"rows":1,"columns":1},"offset":{"rows":0,"columns":0},"elements":[0],"rewriter":0}`)
+
+	_, err := Deserialize(reader)
+
+	if e, ok := err.(*UnsupportedVersionError); ok && e.Version == 4 && e.Error() == IncompatibleVersionError {
+		return
+	}
+
+	t.Fatalf("The unsupported version should cause *UnsupportedVersionError, but %v occurred.", err)
+}
@@ -0,0 +1,127 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Return the determinant of "m".
+// Matrices of size 2, 3 and 4 are computed in the closed form,
+// and the others by Gaussian elimination with partial pivoting.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func Determinant(m Matrix) float64 {
+	validates.ShapeShouldBeSquare(m)
+
+	if s, ok := toSmall(m); ok {
+		return s.determinant()
+	}
+
+	return determinant(rowsExcept(m, -1, -1))
+}
+
+// Return the minor of "m", that is the determinant of "m" without the "row"-th row and "column"-th column.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "row" or "column" is out of range, validates.OUT_OF_RANGE_PANIC will be caused.
+func Minor(m Matrix, row, column int) float64 {
+	validates.ShapeShouldBeSquare(m)
+	validates.IndexShouldBeInRange(m.Rows(), m.Columns(), row, column)
+
+	return determinant(rowsExcept(m, row, column))
+}
+
+// Return the cofactor of "m", that is the minor signed by the parity of "row" + "column".
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused,
+// and when "row" or "column" is out of range, validates.OUT_OF_RANGE_PANIC will be caused.
+func Cofactor(m Matrix, row, column int) float64 {
+	minor := Minor(m, row, column)
+
+	if (row+column)%2 == 1 {
+		return -minor
+	}
+
+	return minor
+}
+
+// Create the adjugate of "m", that is the transpose of the matrix of cofactors.
+// The product of "m" and the adjugate is the determinant times identity,
+// so the inverse of a small non-singular matrix is the adjugate divided by the determinant.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func Adjugate(m Matrix) Matrix {
+	validates.ShapeShouldBeSquare(m)
+
+	size := m.Rows()
+	r := dense.Zeros(size, size)
+
+	for row := 0; row < size; row++ {
+		for column := 0; column < size; column++ {
+			r.Update(column, row, Cofactor(m, row, column))
+		}
+	}
+
+	return r
+}
+
+// Copy the elements of "m" without the "row"-th row and "column"-th column into a slice of rows.
+// Negative "row" and "column" keep all rows and columns.
+func rowsExcept(m Matrix, row, column int) [][]float64 {
+	rows := [][]float64{}
+
+	for i := 0; i < m.Rows(); i++ {
+		if i == row {
+			continue
+		}
+
+		elements := []float64{}
+
+		for j := 0; j < m.Columns(); j++ {
+			if j == column {
+				continue
+			}
+
+			elements = append(elements, m.Get(i, j))
+		}
+
+		rows = append(rows, elements)
+	}
+
+	return rows
+}
+
+// Return the determinant of the square matrix given as the slice of rows, which is overwritten.
+// The determinant of the empty matrix is 1.
+func determinant(rows [][]float64) float64 {
+	size := len(rows)
+	d := 1.0
+
+	for k := 0; k < size; k++ {
+		pivot := k
+		for i := k + 1; i < size; i++ {
+			if math.Abs(rows[i][k]) > math.Abs(rows[pivot][k]) {
+				pivot = i
+			}
+		}
+
+		if rows[pivot][k] == 0 {
+			return 0
+		}
+
+		if pivot != k {
+			rows[pivot], rows[k] = rows[k], rows[pivot]
+			d = -d
+		}
+
+		d *= rows[k][k]
+
+		for i := k + 1; i < size; i++ {
+			factor := rows[i][k] / rows[k][k]
+
+			for j := k + 1; j < size; j++ {
+				rows[i][j] -= factor * rows[k][j]
+			}
+		}
+	}
+
+	return d
+}
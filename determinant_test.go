@@ -0,0 +1,101 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+type determinantTest struct {
+	m           Matrix
+	determinant float64
+}
+
+func TestDeterminantMutableDense(t *testing.T) {
+	tests := []*determinantTest{
+		&determinantTest{
+			m:           dense.New(1, 1)(-3),
+			determinant: -3,
+		},
+		&determinantTest{
+			m: dense.New(2, 2)(
+				1, 2,
+				3, 4,
+			),
+			determinant: -2,
+		},
+		&determinantTest{
+			m: dense.New(3, 3)(
+				0, 2, 1,
+				3, -1, 2,
+				1, 0, 4,
+			),
+			determinant: -19,
+		},
+		&determinantTest{
+			m: dense.New(3, 3)(
+				1, 2, 3,
+				4, 5, 6,
+				7, 8, 9,
+			),
+			determinant: 0,
+		},
+	}
+
+	for _, test := range tests {
+		if d := Determinant(test.m); math.Abs(d-test.determinant) > 1e-12 {
+			t.Fatalf("The determinant should be %v, but is %v.", test.determinant, d)
+		}
+	}
+}
+
+func TestMinorAndCofactorMutableDense(t *testing.T) {
+	m := dense.New(3, 3)(
+		0, 2, 1,
+		3, -1, 2,
+		1, 0, 4,
+	)
+
+	// The minor of (0, 1) is the determinant of [[3, 2], [1, 4]].
+	if minor := Minor(m, 0, 1); math.Abs(minor-10) > 1e-12 {
+		t.Fatalf("The minor should be 10, but is %v.", minor)
+	}
+
+	if cofactor := Cofactor(m, 0, 1); math.Abs(cofactor+10) > 1e-12 {
+		t.Fatalf("The cofactor should be -10, but is %v.", cofactor)
+	}
+
+	if minor := Minor(dense.New(1, 1)(5), 0, 0); minor != 1 {
+		t.Fatalf("The minor of 1x1 matrix should be 1, but is %v.", minor)
+	}
+}
+
+func TestAdjugateMutableDense(t *testing.T) {
+	m := dense.New(3, 3)(
+		0, 2, 1,
+		3, -1, 2,
+		1, 0, 4,
+	)
+
+	r := scaledIdentity(3, Determinant(m))
+
+	if m.Multiply(Adjugate(m)).EqualApprox(r, 1e-12) && Adjugate(m).Multiply(m).EqualApprox(r, 1e-12) {
+		return
+	}
+
+	t.Fatal("The product of a matrix and its adjugate should be the determinant times identity.")
+}
+
+func TestMinorCausesPanicForOutOfRangeMutableDense(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.OUT_OF_RANGE_PANIC {
+			return
+		}
+
+		t.Fatalf("The index out of range should cause %s.", validates.OUT_OF_RANGE_PANIC)
+	}()
+
+	Minor(dense.Zeros(2, 2), 0, 2)
+}
@@ -0,0 +1,88 @@
+package matrix
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+indexFunc is a type of functions which map an index of the result matrix
+to the one of the source matrix of size "n".
+*/
+type indexFunc func(row, column, n int) (int, int)
+
+var rotations = []indexFunc{
+	func(row, column, n int) (int, int) { return row, column },
+	func(row, column, n int) (int, int) { return n - 1 - column, row },
+	func(row, column, n int) (int, int) { return n - 1 - row, n - 1 - column },
+	func(row, column, n int) (int, int) { return column, n - 1 - row },
+}
+
+// Create the 8 matrices obtained by rotating and reflecting the square matrix "m".
+// The first 4 are rotations by 0, 90, 180 and 270 degrees clockwise,
+// and the rest are the same rotations applied after flipping "m" horizontally.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func Dihedral(m Matrix) []Matrix {
+	validates.ShapeShouldBeSquare(m)
+
+	transforms := make([]Matrix, 0, 2*len(rotations))
+
+	for _, flip := range []bool{false, true} {
+		for _, rotate := range rotations {
+			transforms = append(transforms, transform(m, rotate, flip))
+		}
+	}
+
+	return transforms
+}
+
+// Return the canonical form of the square matrix "m" under rotations and reflections,
+// which is the one of Dihedral(m) whose elements are the smallest in row-major order.
+// Two matrices are symmetric to each other if and only if their canonical forms are equal.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func Canonical(m Matrix) Matrix {
+	transforms := Dihedral(m)
+
+	canonical := transforms[0]
+
+	for _, n := range transforms[1:] {
+		if lessRowMajor(n, canonical) {
+			canonical = n
+		}
+	}
+
+	return canonical
+}
+
+// Create a new matrix by rearranging elements of the square matrix "m".
+func transform(m Matrix, rotate indexFunc, flip bool) Matrix {
+	n := m.Rows()
+
+	r := dense.Zeros(n, n)
+
+	for row := 0; row < n; row++ {
+		for column := 0; column < n; column++ {
+			i, j := rotate(row, column, n)
+			if flip {
+				j = n - 1 - j
+			}
+
+			r.Update(row, column, m.Get(i, j))
+		}
+	}
+
+	return r
+}
+
+// Check whether elements of "m" precede ones of "n" in row-major lexicographic order.
+func lessRowMajor(m, n Matrix) bool {
+	for row := 0; row < m.Rows(); row++ {
+		for column := 0; column < m.Columns(); column++ {
+			if a, b := m.Get(row, column), n.Get(row, column); a != b {
+				return a < b
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestDihedralMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	results := []Matrix{
+		dense.New(2, 2)(1, 2, 3, 4),
+		dense.New(2, 2)(3, 1, 4, 2),
+		dense.New(2, 2)(4, 3, 2, 1),
+		dense.New(2, 2)(2, 4, 1, 3),
+		dense.New(2, 2)(2, 1, 4, 3),
+		dense.New(2, 2)(4, 2, 3, 1),
+		dense.New(2, 2)(3, 4, 1, 2),
+		dense.New(2, 2)(1, 3, 2, 4),
+	}
+
+	transforms := Dihedral(m)
+
+	if len(transforms) != len(results) {
+		t.Fatalf("Dihedral should create %d matrices, but creates %d.", len(results), len(transforms))
+	}
+
+	for i, r := range results {
+		if !transforms[i].Equal(r) {
+			t.Fatalf("The %d-th transform is wrong.", i)
+		}
+	}
+}
+
+func TestDihedralCausesPanicForNonSquareMutableDense(t *testing.T) {
+	m := dense.Zeros(2, 3)
+
+	defer func() {
+		if p := recover(); p == validates.NOT_SQUARE_PANIC {
+			return
+		}
+
+		t.Fatalf("Non-square matrix should cause %s.", validates.NOT_SQUARE_PANIC)
+	}()
+	Dihedral(m)
+}
+
+func TestCanonicalIsInvariantUnderSymmetryMutableDense(t *testing.T) {
+	m := dense.New(3, 3)(
+		0, 1, 2,
+		3, 4, 5,
+		6, 7, 8,
+	)
+
+	canonical := Canonical(m)
+
+	for i, n := range Dihedral(m) {
+		if !Canonical(n).Equal(canonical) {
+			t.Fatalf("The canonical form of the %d-th transform should equal to the original one.", i)
+		}
+	}
+}
+
+func TestCanonicalIsTheSmallestMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		4, 3,
+		2, 1,
+	)
+
+	r := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	if Canonical(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("The canonical form should be the smallest in row-major order.")
+}
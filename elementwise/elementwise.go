@@ -0,0 +1,49 @@
+/*
+Package "elementwise" provides unary math functions applied to every element of matrix.
+When the given matrix is mutable, its elements are rewritten and the matrix itself is returned.
+*/
+package elementwise
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Replace every element of "m" with the result of "f".
+// Dense matrices are processed in a single pass over the backing elements.
+func Apply(m types.Matrix, f func(element float64) float64) types.Matrix {
+	if d, isDense := m.(*dense.Matrix); isDense {
+		return d.Apply(f)
+	}
+
+	cursor := m.All()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		m.Update(row, column, f(element))
+	}
+
+	return m
+}
+
+// Replace every element of "m" with its absolute value.
+func Abs(m types.Matrix) types.Matrix {
+	return Apply(m, math.Abs)
+}
+
+// Replace every element of "m" with its base-e exponential.
+func Exp(m types.Matrix) types.Matrix {
+	return Apply(m, math.Exp)
+}
+
+// Replace every element of "m" with its natural logarithm.
+func Log(m types.Matrix) types.Matrix {
+	return Apply(m, math.Log)
+}
+
+// Replace every element of "m" with its square root.
+func Sqrt(m types.Matrix) types.Matrix {
+	return Apply(m, math.Sqrt)
+}
@@ -0,0 +1,88 @@
+package elementwise
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestAbsMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		-1, 2,
+		0, -3.5,
+	)
+
+	r := dense.New(2, 2)(
+		1, 2,
+		0, 3.5,
+	)
+
+	if Abs(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("Abs should replace every element with its absolute value.")
+}
+
+func TestExpMutableDense(t *testing.T) {
+	m := dense.New(1, 2)(0, 1)
+
+	r := dense.New(1, 2)(1, math.E)
+
+	if Exp(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("Exp should replace every element with its exponential.")
+}
+
+func TestLogMutableDense(t *testing.T) {
+	m := dense.New(1, 2)(1, math.E)
+
+	r := dense.New(1, 2)(0, 1)
+
+	if Log(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("Log should replace every element with its logarithm.")
+}
+
+func TestSqrtMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		0, 1,
+		4, 9,
+	)
+
+	r := dense.New(2, 2)(
+		0, 1,
+		2, 3,
+	)
+
+	if Sqrt(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("Sqrt should replace every element with its square root.")
+}
+
+func TestSqrtOfViewMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		4, 4, 4,
+		4, 9, 16,
+	)
+
+	Sqrt(m.Row(1).View(0, 1, 1, 2))
+
+	r := dense.New(2, 3)(
+		4, 4, 4,
+		4, 3, 4,
+	)
+
+	if m.Equal(r) {
+		return
+	}
+
+	t.Fatal("Sqrt should rewrite only the elements of the view.")
+}
@@ -0,0 +1,263 @@
+/*
+Package "csvmat" reads and writes dense matrices as CSV.
+*/
+package csvmat
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	EmptyError = "EmptyError"
+)
+
+/*
+"ElementError" is returned by (*Reader).Read
+when a cell cannot be parsed as a floating-point number.
+*/
+type ElementError struct {
+	Row    int
+	Column int
+	Text   string
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("cell (%d, %d) is not a number: %q", e.Row, e.Column, e.Text)
+}
+
+/*
+"Reader" reads a matrix from CSV or other delimited text.
+Every record should have the same number of fields.
+*/
+type Reader struct {
+	reader io.Reader
+
+	// When "HasHeader" is true, the first record is read as the names of columns.
+	HasHeader bool
+
+	// "Comma" is the field delimiter, e.g. '\t' for TSV.
+	Comma rune
+
+	// When "Comment" is not zero, lines beginning with it are skipped.
+	Comment rune
+
+	// When "Whitespace" is true, fields are separated by runs of spaces and tabs,
+	// and "Comma" is ignored.
+	Whitespace bool
+
+	// "Missing" is the element read from empty cells, e.g. math.NaN().
+	Missing float64
+}
+
+// Create a new reader for CSV read from "r".
+// Empty cells are read as zero by default.
+func NewReader(r io.Reader) *Reader {
+	reader := &Reader{
+		reader: r,
+		Comma:  ',',
+	}
+
+	return reader
+}
+
+// Read all records as a new matrix.
+// When "HasHeader" is true, the names of columns are returned as "header".
+// When no record of elements is found, EmptyError is returned.
+func (r *Reader) Read() (m *dense.Matrix, header []string, err error) {
+	records, err := r.records()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if r.HasHeader && len(records) > 0 {
+		header, records = records[0], records[1:]
+	}
+
+	if len(records) == 0 || len(records[0]) == 0 {
+		return nil, nil, errors.New(EmptyError)
+	}
+
+	rows, columns := len(records), len(records[0])
+
+	if err := dense.CheckShape(rows, columns); err != nil {
+		return nil, nil, err
+	}
+
+	elements := make([]float64, 0, rows*columns)
+
+	for row, record := range records {
+		for column, text := range record {
+			element, err := r.parse(text)
+			if err != nil {
+				return nil, nil, &ElementError{Row: row, Column: column, Text: text}
+			}
+
+			elements = append(elements, element)
+		}
+	}
+
+	return dense.New(rows, columns)(elements...), header, nil
+}
+
+// Read all records as lists of fields.
+func (r *Reader) records() ([][]string, error) {
+	if r.Whitespace {
+		return r.fields()
+	}
+
+	reader := csv.NewReader(r.reader)
+	reader.Comma = r.Comma
+	reader.Comment = r.Comment
+	reader.TrimLeadingSpace = true
+
+	return reader.ReadAll()
+}
+
+// Read all records separated by runs of spaces and tabs, skipping blank and comment lines.
+// Lines are read with "bufio.Reader", so they are not limited in length as in "encoding/csv".
+func (r *Reader) fields() ([][]string, error) {
+	records := [][]string{}
+	columns := -1
+
+	reader := bufio.NewReader(r.reader)
+
+	for line := 1; ; line++ {
+		text, err := reader.ReadString('\n')
+		if err == io.EOF && text == "" {
+			break
+		}
+
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		text = strings.TrimSpace(text)
+
+		if text == "" || r.Comment != 0 && strings.HasPrefix(text, string(r.Comment)) {
+			continue
+		}
+
+		record := strings.Fields(text)
+
+		if columns < 0 {
+			columns = len(record)
+		}
+
+		if len(record) != columns {
+			return nil, &csv.ParseError{Line: line, Err: csv.ErrFieldCount}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// Parse a cell as a floating-point number.
+func (r *Reader) parse(text string) (float64, error) {
+	text = strings.TrimSpace(text)
+
+	if text == "" {
+		return r.Missing, nil
+	}
+
+	return strconv.ParseFloat(text, 64)
+}
+
+/*
+"Writer" writes a matrix as CSV.
+*/
+type Writer struct {
+	writer *csv.Writer
+
+	// When "Header" is not nil, it is written as the first record.
+	Header []string
+
+	// "Format" and "Precision" are passed to strconv.FormatFloat.
+	Format    byte
+	Precision int
+
+	// When "Sparse" is true, zeros are written as empty cells
+	// and only non-zero elements are formatted.
+	Sparse bool
+}
+
+// Create a new writer for CSV written to "w".
+// The elements are formatted with the shortest representation by default.
+func NewWriter(w io.Writer) *Writer {
+	writer := &Writer{
+		writer:    csv.NewWriter(w),
+		Format:    'g',
+		Precision: -1,
+	}
+
+	return writer
+}
+
+// Write "m" as CSV.
+// When the length of "Header" differs from the columns of "m",
+// validates.DIFFERENT_SIZE_PANIC will be caused.
+func (w *Writer) Write(m types.Matrix) error {
+	rows, columns := m.Shape()
+
+	if w.Header != nil {
+		if len(w.Header) != columns {
+			panic(validates.DIFFERENT_SIZE_PANIC)
+		}
+
+		if err := w.writer.Write(w.Header); err != nil {
+			return err
+		}
+	}
+
+	zero := w.format(0)
+	if w.Sparse {
+		zero = ""
+	}
+
+	records := make([][]string, rows)
+
+	for row := range records {
+		record := make([]string, columns)
+		for column := range record {
+			record[column] = zero
+		}
+
+		records[row] = record
+	}
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		records[row][column] = w.format(element)
+	}
+
+	return w.writer.WriteAll(records)
+}
+
+func (w *Writer) format(element float64) string {
+	return strconv.FormatFloat(element, w.Format, w.Precision, 64)
+}
+
+// Read a matrix from CSV without header.
+func Read(r io.Reader) (*dense.Matrix, error) {
+	m, _, err := NewReader(r).Read()
+
+	return m, err
+}
+
+// Write "m" as CSV without header.
+func Write(w io.Writer, m types.Matrix) error {
+	return NewWriter(w).Write(m)
+}
@@ -0,0 +1,252 @@
+package csvmat
+
+import (
+	"bytes"
+	"encoding/csv"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestReadReturnsMatrix(t *testing.T) {
+	m, err := Read(strings.NewReader("1,2.5,-3\n4,,6e2\n"))
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	r := dense.New(2, 3)(
+		1, 2.5, -3,
+		4, 0, 600,
+	)
+
+	if m.Equal(r) {
+		return
+	}
+
+	t.Fatal("The matrix read from CSV is wrong.")
+}
+
+func TestReadWithHeaderReturnsNames(t *testing.T) {
+	reader := NewReader(strings.NewReader("x,y\n1,2\n3,4\n"))
+	reader.HasHeader = true
+
+	m, header, err := reader.Read()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if len(header) != 2 || header[0] != "x" || header[1] != "y" {
+		t.Fatalf("The header should be [x y], but is %v.", header)
+	}
+
+	if m.Equal(dense.New(2, 2)(1, 2, 3, 4)) {
+		return
+	}
+
+	t.Fatal("The matrix read from CSV with header is wrong.")
+}
+
+func TestReadFailsForInvalidCSV(t *testing.T) {
+	tests := []string{
+		"",
+		"x,y\n",
+		"1,2\n3\n",
+		"1,2\n3,four\n",
+	}
+
+	for index, test := range tests {
+		reader := NewReader(strings.NewReader(test))
+		reader.HasHeader = index == 1
+
+		if _, _, err := reader.Read(); err == nil {
+			t.Fatalf("Reading %q should fail.", test)
+		}
+	}
+}
+
+func TestReadReturnsElementError(t *testing.T) {
+	_, err := Read(strings.NewReader("1,2\n3,four\n"))
+
+	e, ok := err.(*ElementError)
+	if !ok {
+		t.Fatalf("The error should be *ElementError, but is %T.", err)
+	}
+
+	if e.Row == 1 && e.Column == 1 && e.Text == "four" {
+		return
+	}
+
+	t.Fatalf("The error should point (1, 1), but is %q.", e)
+}
+
+func TestWriteReturnsCSV(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2.5, 0,
+		0, -3, 1e-9,
+	)
+
+	buffer := &bytes.Buffer{}
+
+	if err := Write(buffer, m); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if s := buffer.String(); s != "1,2.5,0\n0,-3,1e-09\n" {
+		t.Fatalf("The CSV is wrong: %q.", s)
+	}
+}
+
+func TestWriteWithOptionsReturnsCSV(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 0,
+		0, 2.125,
+	).Transpose()
+
+	buffer := &bytes.Buffer{}
+
+	writer := NewWriter(buffer)
+	writer.Header = []string{"a", "b"}
+	writer.Format = 'f'
+	writer.Precision = 2
+	writer.Sparse = true
+
+	if err := writer.Write(m); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if s := buffer.String(); s != "a,b\n1.00,\n,2.12\n" {
+		t.Fatalf("The CSV is wrong: %q.", s)
+	}
+}
+
+func TestWriteAndReadReturnsTheOriginal(t *testing.T) {
+	m := dense.New(3, 2)(
+		0.1, 1.0/3,
+		0, -2e100,
+		5e-324, 7,
+	)
+
+	buffer := &bytes.Buffer{}
+
+	writer := NewWriter(buffer)
+	writer.Sparse = true
+
+	if err := writer.Write(m); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	n, err := Read(buffer)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if m.Equal(n) {
+		return
+	}
+
+	t.Fatal("The matrix read from the written CSV should equal to the original.")
+}
+
+func TestWriteCausesPanicForHeaderOfDifferentSize(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatalf("The header of different size should cause %s.", validates.DIFFERENT_SIZE_PANIC)
+	}()
+
+	writer := NewWriter(&bytes.Buffer{})
+	writer.Header = []string{"a"}
+
+	writer.Write(dense.Zeros(1, 2))
+}
+
+func TestReadWithDelimiterAndComment(t *testing.T) {
+	reader := NewReader(strings.NewReader("# x\ty\n1\t2\n# skipped\n3\t4\n"))
+	reader.Comma = '\t'
+	reader.Comment = '#'
+
+	m, _, err := reader.Read()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if m.Equal(dense.New(2, 2)(1, 2, 3, 4)) {
+		return
+	}
+
+	t.Fatal("The matrix read from TSV is wrong.")
+}
+
+func TestReadWithWhitespace(t *testing.T) {
+	text := "% energy   time\n  1.5   2\n\n3 \t -4e1  \n"
+
+	reader := NewReader(strings.NewReader(text))
+	reader.Whitespace = true
+	reader.Comment = '%'
+
+	m, _, err := reader.Read()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if m.Equal(dense.New(2, 2)(1.5, 2, 3, -40)) {
+		return
+	}
+
+	t.Fatal("The matrix read from whitespace-separated text is wrong.")
+}
+
+func TestReadWithWhitespaceReadsWideRows(t *testing.T) {
+	columns := 20000
+
+	// The row is longer than the default token limit of "bufio.Scanner".
+	row := strings.TrimSpace(strings.Repeat("1.25 ", columns))
+
+	reader := NewReader(strings.NewReader(row + "\n" + row))
+	reader.Whitespace = true
+
+	m, _, err := reader.Read()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if rows, c := m.Shape(); rows == 2 && c == columns && m.Get(1, columns-1) == 1.25 {
+		return
+	}
+
+	t.Fatal("The matrix read from wide rows is wrong.")
+}
+
+func TestReadWithWhitespaceFailsForRaggedRows(t *testing.T) {
+	reader := NewReader(strings.NewReader("1 2\n3\n"))
+	reader.Whitespace = true
+
+	_, _, err := reader.Read()
+
+	if e, ok := err.(*csv.ParseError); ok && e.Line == 2 && e.Err == csv.ErrFieldCount {
+		return
+	}
+
+	t.Fatalf("Ragged rows should cause the error of field count, but %v occurred.", err)
+}
+
+func TestReadWithMissing(t *testing.T) {
+	reader := NewReader(strings.NewReader("1,\n, 4\n"))
+	reader.Missing = math.NaN()
+
+	m, _, err := reader.Read()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if m.Get(0, 0) == 1 && math.IsNaN(m.Get(0, 1)) && math.IsNaN(m.Get(1, 0)) && m.Get(1, 1) == 4 {
+		return
+	}
+
+	t.Fatal("Empty cells should be read as the missing value.")
+}
@@ -0,0 +1,832 @@
+/*
+Package "hdf5" reads 2-dimensional numeric datasets in HDF5 files as dense matrices.
+Only a subset of the format is supported:
+files with superblock version 0 or 1 and version 1 object headers,
+which are written by default with the HDF5 library and h5py,
+and datasets of floating-point or integer elements stored contiguously or compactly.
+Chunked and compressed datasets are not supported.
+*/
+package hdf5
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+const (
+	InvalidFormatError     = "InvalidFormatError"
+	UnsupportedFormatError = "UnsupportedFormatError"
+	NotFoundError          = "NotFoundError"
+)
+
+const (
+	signature = "\x89HDF\r\n\x1a\n"
+
+	// The superblock is placed at 0 or one of the power of two multiples of 512.
+	firstSuperblock = 512
+	lastSuperblock  = 1 << 30
+
+	// The limit of nested groups and object header continuations to stop reading broken files.
+	maxDepth = 64
+)
+
+const (
+	dataspaceMessage    = 0x0001
+	datatypeMessage     = 0x0003
+	layoutMessage       = 0x0008
+	continuationMessage = 0x0010
+	symbolTableMessage  = 0x0011
+)
+
+const (
+	fixedPointClass    = 0
+	floatingPointClass = 1
+)
+
+const (
+	compactLayout    = 0
+	contiguousLayout = 1
+)
+
+/*
+"File" is an HDF5 file opened for reading datasets.
+*/
+type File struct {
+	reader     io.ReaderAt
+	offsetSize int
+	lengthSize int
+	base       uint64
+	root       *entry
+}
+
+// A symbol table entry, which links a name to an object header.
+type entry struct {
+	name       string
+	nameOffset uint64
+	header     uint64
+
+	// The addresses of the B-tree and the local heap cached for groups.
+	cached bool
+	btree  uint64
+	heap   uint64
+}
+
+type message struct {
+	kind  uint16
+	flags uint8
+	data  []byte
+}
+
+// Read the dataset at "path" such as "/group/dataset" from HDF5 file.
+func Read(r io.ReaderAt, path string) (*dense.Matrix, error) {
+	f, err := Open(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Read(path)
+}
+
+// Open HDF5 file read from "r".
+// When the superblock is not found, InvalidFormatError is returned,
+// and when the version of superblock is not supported, UnsupportedFormatError is returned.
+func Open(r io.ReaderAt) (*File, error) {
+	f := &File{reader: r}
+
+	for address := int64(0); address <= lastSuperblock; {
+		b := make([]byte, len(signature))
+		if n, _ := r.ReadAt(b, address); n < len(b) {
+			break
+		}
+
+		if string(b) == signature {
+			if err := f.readSuperblock(address); err != nil {
+				return nil, err
+			}
+
+			return f, nil
+		}
+
+		if address == 0 {
+			address = firstSuperblock
+		} else {
+			address *= 2
+		}
+	}
+
+	return nil, errors.New(InvalidFormatError)
+}
+
+func (f *File) readSuperblock(address int64) error {
+	b := make([]byte, 28)
+	if n, _ := f.reader.ReadAt(b, address+int64(len(signature))); n < len(b) {
+		return errors.New(InvalidFormatError)
+	}
+
+	version := b[0]
+	if version > 1 {
+		return errors.New(UnsupportedFormatError)
+	}
+
+	f.offsetSize, f.lengthSize = int(b[5]), int(b[6])
+	if !validSize(f.offsetSize) || !validSize(f.lengthSize) {
+		return errors.New(InvalidFormatError)
+	}
+
+	fixed := 16
+	if version == 1 {
+		fixed += 4
+	}
+
+	// The base address, the free-space address, the end-of-file address and the driver address
+	// are followed by the symbol table entry of the root group.
+	rest := make([]byte, 4*f.offsetSize+f.entrySize())
+	if n, _ := f.reader.ReadAt(rest, address+int64(len(signature)+fixed)); n < len(rest) {
+		return errors.New(InvalidFormatError)
+	}
+
+	d := f.decoder(rest)
+	f.base = d.offset()
+	d.skip(3 * f.offsetSize)
+	f.root = f.decodeEntry(d)
+
+	if d.err != nil {
+		return d.err
+	}
+
+	return nil
+}
+
+func validSize(size int) bool {
+	return size == 2 || size == 4 || size == 8
+}
+
+// Read the dataset at "path" such as "/group/dataset" as a matrix.
+// The elements are converted to float64.
+// 2-dimensional datasets are read as they are, and 1-dimensional datasets are read as row vectors.
+// When no dataset is found at the path, NotFoundError is returned,
+// and when the dataset is stored in an unsupported way, UnsupportedFormatError is returned.
+func (f *File) Read(path string) (*dense.Matrix, error) {
+	e, err := f.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := f.messages(e.header)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.readDataset(messages)
+}
+
+// List the paths of all datasets in the file.
+func (f *File) Datasets() ([]string, error) {
+	paths := []string{}
+
+	err := f.walk(f.root, "", map[uint64]bool{}, 0, func(path string) {
+		paths = append(paths, path)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+func (f *File) walk(e *entry, path string, visited map[uint64]bool, depth int, found func(path string)) error {
+	if depth > maxDepth || visited[e.header] {
+		return nil
+	}
+	visited[e.header] = true
+
+	messages, err := f.messages(e.header)
+	if err != nil {
+		return err
+	}
+
+	if btree, heap, ok := f.groupOf(e, messages); ok {
+		children, err := f.children(btree, heap)
+		if err != nil {
+			return err
+		}
+
+		for _, child := range children {
+			if err := f.walk(child, path+"/"+child.name, visited, depth+1, found); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if find(messages, layoutMessage) != nil {
+		found(path)
+	}
+
+	return nil
+}
+
+// Find the symbol table entry at "path".
+func (f *File) lookup(path string) (*entry, error) {
+	e := f.root
+
+	for _, name := range strings.Split(path, "/") {
+		if name == "" {
+			continue
+		}
+
+		messages, err := f.messages(e.header)
+		if err != nil {
+			return nil, err
+		}
+
+		btree, heap, ok := f.groupOf(e, messages)
+		if !ok {
+			return nil, errors.New(NotFoundError)
+		}
+
+		children, err := f.children(btree, heap)
+		if err != nil {
+			return nil, err
+		}
+
+		e = nil
+		for _, child := range children {
+			if child.name == name {
+				e = child
+				break
+			}
+		}
+
+		if e == nil {
+			return nil, errors.New(NotFoundError)
+		}
+	}
+
+	return e, nil
+}
+
+// Return the addresses of the B-tree and the local heap when the entry is a group.
+func (f *File) groupOf(e *entry, messages []*message) (btree, heap uint64, ok bool) {
+	if e.cached {
+		return e.btree, e.heap, true
+	}
+
+	m := find(messages, symbolTableMessage)
+	if m == nil {
+		return 0, 0, false
+	}
+
+	d := f.decoder(m.data)
+	btree, heap = d.offset(), d.offset()
+
+	return btree, heap, d.err == nil
+}
+
+// Read the entries of a group from its B-tree and local heap.
+func (f *File) children(btree, heap uint64) ([]*entry, error) {
+	names, err := f.readHeap(heap)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*entry{}
+
+	if err := f.readGroupNode(btree, names, &entries, 0); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Read the data segment of a local heap.
+func (f *File) readHeap(address uint64) ([]byte, error) {
+	b, err := f.read(address, 8+2*f.lengthSize+f.offsetSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(b[:4]) != "HEAP" {
+		return nil, errors.New(InvalidFormatError)
+	}
+
+	d := f.decoder(b[8:])
+	size := d.length()
+	d.skip(f.lengthSize)
+	data := d.offset()
+
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	if size > math.MaxInt32 {
+		return nil, errors.New(InvalidFormatError)
+	}
+
+	return f.read(data, int(size))
+}
+
+// Read a node of the B-tree of a group, and append the entries found in its leaves.
+func (f *File) readGroupNode(address uint64, names []byte, entries *[]*entry, depth int) error {
+	if depth > maxDepth {
+		return errors.New(InvalidFormatError)
+	}
+
+	header, err := f.read(address, 8+2*f.offsetSize)
+	if err != nil {
+		return err
+	}
+
+	if string(header[:4]) != "TREE" || header[4] != 0 {
+		return errors.New(InvalidFormatError)
+	}
+
+	level := header[5]
+	used := int(binary.LittleEndian.Uint16(header[6:8]))
+
+	b, err := f.read(address+uint64(len(header)), used*(f.lengthSize+f.offsetSize)+f.lengthSize)
+	if err != nil {
+		return err
+	}
+
+	d := f.decoder(b)
+
+	for index := 0; index < used; index++ {
+		d.skip(f.lengthSize)
+		child := d.offset()
+
+		if d.err != nil {
+			return d.err
+		}
+
+		if level > 0 {
+			err = f.readGroupNode(child, names, entries, depth+1)
+		} else {
+			err = f.readSymbolNode(child, names, entries)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Read a symbol table node, and append its entries.
+func (f *File) readSymbolNode(address uint64, names []byte, entries *[]*entry) error {
+	header, err := f.read(address, 8)
+	if err != nil {
+		return err
+	}
+
+	if string(header[:4]) != "SNOD" {
+		return errors.New(InvalidFormatError)
+	}
+
+	count := int(binary.LittleEndian.Uint16(header[6:8]))
+
+	b, err := f.read(address+8, count*f.entrySize())
+	if err != nil {
+		return err
+	}
+
+	d := f.decoder(b)
+
+	for index := 0; index < count; index++ {
+		e := f.decodeEntry(d)
+		if d.err != nil {
+			return d.err
+		}
+
+		e.name = heapString(names, e.nameOffset)
+		*entries = append(*entries, e)
+	}
+
+	return nil
+}
+
+// The size of a symbol table entry.
+func (f *File) entrySize() int {
+	return 2*f.offsetSize + 8 + 16
+}
+
+// Decode a symbol table entry.
+// The name is left as the offset in the local heap, which is resolved by the caller.
+func (f *File) decodeEntry(d *decoder) *entry {
+	e := &entry{
+		nameOffset: d.offset(),
+		header:     d.offset(),
+	}
+
+	cache := d.uint32()
+	d.skip(4)
+
+	scratch := d.bytes(16)
+
+	if cache == 1 && d.err == nil {
+		s := f.decoder(scratch)
+		e.btree, e.heap = s.offset(), s.offset()
+		e.cached = s.err == nil
+	}
+
+	return e
+}
+
+// Read the messages of the object header at "address" including the continuations.
+func (f *File) messages(address uint64) ([]*message, error) {
+	prefix, err := f.read(address, 16)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(prefix[:4]) == "OHDR" {
+		return nil, errors.New(UnsupportedFormatError)
+	}
+
+	if prefix[0] != 1 {
+		return nil, errors.New(InvalidFormatError)
+	}
+
+	count := int(binary.LittleEndian.Uint16(prefix[2:4]))
+	size := int(binary.LittleEndian.Uint32(prefix[8:12]))
+
+	messages := []*message{}
+
+	block, blockSize := address+16, size
+
+	for depth := 0; len(messages) < count; depth++ {
+		if depth > maxDepth {
+			return nil, errors.New(InvalidFormatError)
+		}
+
+		b, err := f.read(block, blockSize)
+		if err != nil {
+			return nil, err
+		}
+
+		continued := false
+
+		for len(b) >= 8 && len(messages) < count {
+			kind := binary.LittleEndian.Uint16(b[0:2])
+			length := int(binary.LittleEndian.Uint16(b[2:4]))
+
+			if 8+length > len(b) {
+				return nil, errors.New(InvalidFormatError)
+			}
+
+			m := &message{kind: kind, flags: b[4], data: b[8 : 8+length]}
+			messages = append(messages, m)
+
+			b = b[8+length:]
+
+			if kind == continuationMessage {
+				d := f.decoder(m.data)
+				block, blockSize = d.offset(), int(d.length())
+
+				if d.err != nil {
+					return nil, d.err
+				}
+
+				continued = true
+			}
+		}
+
+		if !continued {
+			break
+		}
+	}
+
+	return messages, nil
+}
+
+func find(messages []*message, kind uint16) *message {
+	for _, m := range messages {
+		if m.kind == kind {
+			return m
+		}
+	}
+
+	return nil
+}
+
+func (f *File) readDataset(messages []*message) (*dense.Matrix, error) {
+	space, datatype, layout := find(messages, dataspaceMessage), find(messages, datatypeMessage), find(messages, layoutMessage)
+	if space == nil || datatype == nil || layout == nil {
+		return nil, errors.New(NotFoundError)
+	}
+
+	rows, columns, err := f.decodeDataspace(space)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dense.CheckShape(rows, columns); err != nil {
+		return nil, err
+	}
+
+	decode, size, err := decodeDatatype(datatype)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := f.readData(layout, rows*columns*size)
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([]float64, rows*columns)
+
+	if data != nil {
+		for index := range elements {
+			elements[index] = decode(data[index*size : (index+1)*size])
+		}
+	}
+
+	return dense.New(rows, columns)(elements...), nil
+}
+
+// Decode the shape of the dataspace.
+func (f *File) decodeDataspace(m *message) (rows, columns int, err error) {
+	d := f.decoder(m.data)
+
+	version := d.uint8()
+	rank := int(d.uint8())
+	d.skip(1)
+
+	switch version {
+	case 1:
+		d.skip(5)
+	case 2:
+		d.skip(1)
+	default:
+		return 0, 0, errors.New(UnsupportedFormatError)
+	}
+
+	dimensions := make([]uint64, rank)
+	for index := range dimensions {
+		dimensions[index] = d.length()
+	}
+
+	if d.err != nil {
+		return 0, 0, d.err
+	}
+
+	for _, dimension := range dimensions {
+		if dimension > math.MaxInt32 {
+			return 0, 0, errors.New(dense.SizeOverflowError)
+		}
+	}
+
+	switch rank {
+	case 1:
+		return 1, int(dimensions[0]), nil
+	case 2:
+		return int(dimensions[0]), int(dimensions[1]), nil
+	}
+
+	return 0, 0, errors.New(UnsupportedFormatError)
+}
+
+// Create the function to decode an element of the datatype, and return the size of elements.
+func decodeDatatype(m *message) (decode func(b []byte) float64, size int, err error) {
+	if len(m.data) < 8 || m.flags&0x02 != 0 {
+		return nil, 0, errors.New(UnsupportedFormatError)
+	}
+
+	class := m.data[0] & 0x0f
+	bits := m.data[1]
+	size = int(binary.LittleEndian.Uint32(m.data[4:8]))
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if bits&0x01 != 0 {
+		order = binary.BigEndian
+	}
+
+	switch class {
+	case floatingPointClass:
+		if bits&0x40 != 0 {
+			return nil, 0, errors.New(UnsupportedFormatError)
+		}
+
+		switch size {
+		case 4:
+			decode = func(b []byte) float64 { return float64(math.Float32frombits(order.Uint32(b))) }
+		case 8:
+			decode = func(b []byte) float64 { return math.Float64frombits(order.Uint64(b)) }
+		}
+	case fixedPointClass:
+		signed := bits&0x08 != 0
+
+		switch size {
+		case 1:
+			if signed {
+				decode = func(b []byte) float64 { return float64(int8(b[0])) }
+			} else {
+				decode = func(b []byte) float64 { return float64(b[0]) }
+			}
+		case 2:
+			if signed {
+				decode = func(b []byte) float64 { return float64(int16(order.Uint16(b))) }
+			} else {
+				decode = func(b []byte) float64 { return float64(order.Uint16(b)) }
+			}
+		case 4:
+			if signed {
+				decode = func(b []byte) float64 { return float64(int32(order.Uint32(b))) }
+			} else {
+				decode = func(b []byte) float64 { return float64(order.Uint32(b)) }
+			}
+		case 8:
+			if signed {
+				decode = func(b []byte) float64 { return float64(int64(order.Uint64(b))) }
+			} else {
+				decode = func(b []byte) float64 { return float64(order.Uint64(b)) }
+			}
+		}
+	}
+
+	if decode == nil {
+		return nil, 0, errors.New(UnsupportedFormatError)
+	}
+
+	return decode, size, nil
+}
+
+// Read "size" bytes of raw data for the layout.
+// When the data has never been written, nil is returned to fill elements with zeros.
+func (f *File) readData(m *message, size int) ([]byte, error) {
+	d := f.decoder(m.data)
+
+	version := d.uint8()
+
+	var class uint8
+	var address uint64
+	var compact []byte
+
+	switch version {
+	case 1, 2:
+		rank := int(d.uint8())
+		class = d.uint8()
+		d.skip(5)
+
+		if class != compactLayout {
+			address = d.offset()
+		}
+
+		d.skip(4 * rank)
+
+		if class == compactLayout {
+			compact = d.bytes(int(d.uint32()))
+		}
+	case 3, 4:
+		class = d.uint8()
+
+		switch class {
+		case compactLayout:
+			compact = d.bytes(int(d.uint16()))
+		case contiguousLayout:
+			address = d.offset()
+		}
+	default:
+		return nil, errors.New(UnsupportedFormatError)
+	}
+
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	switch class {
+	case compactLayout:
+		if len(compact) < size {
+			return nil, errors.New(InvalidFormatError)
+		}
+
+		return compact, nil
+	case contiguousLayout:
+		if f.undefined(address) {
+			return nil, nil
+		}
+
+		return f.read(address, size)
+	}
+
+	return nil, errors.New(UnsupportedFormatError)
+}
+
+// Read "size" bytes at the address relative to the base address.
+func (f *File) read(address uint64, size int) ([]byte, error) {
+	if size < 0 || f.undefined(address) || address > math.MaxInt64-f.base {
+		return nil, errors.New(InvalidFormatError)
+	}
+
+	b := make([]byte, size)
+
+	if n, err := f.reader.ReadAt(b, int64(f.base+address)); n < size {
+		if err == nil || err == io.EOF {
+			err = errors.New(InvalidFormatError)
+		}
+
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Check whether the address is the undefined address, all of whose bits are set.
+func (f *File) undefined(address uint64) bool {
+	return address == math.MaxUint64>>uint(64-8*f.offsetSize)
+}
+
+// Find the null-terminated name at the offset of the local heap.
+func heapString(names []byte, offset uint64) string {
+	if offset >= uint64(len(names)) {
+		return ""
+	}
+
+	name := names[offset:]
+	if end := bytes.IndexByte(name, 0); end >= 0 {
+		name = name[:end]
+	}
+
+	return string(name)
+}
+
+/*
+"decoder" reads little-endian fields from a byte slice.
+Once the slice is exhausted, InvalidFormatError is kept in "err" and zeros are returned.
+*/
+type decoder struct {
+	b          []byte
+	offsetSize int
+	lengthSize int
+	err        error
+}
+
+func (f *File) decoder(b []byte) *decoder {
+	d := &decoder{
+		b:          b,
+		offsetSize: f.offsetSize,
+		lengthSize: f.lengthSize,
+	}
+
+	return d
+}
+
+func (d *decoder) bytes(size int) []byte {
+	if d.err != nil || size < 0 || size > len(d.b) {
+		d.err = errors.New(InvalidFormatError)
+		return nil
+	}
+
+	b := d.b[:size]
+	d.b = d.b[size:]
+
+	return b
+}
+
+func (d *decoder) skip(size int) {
+	d.bytes(size)
+}
+
+func (d *decoder) uint8() uint8 {
+	if b := d.bytes(1); b != nil {
+		return b[0]
+	}
+
+	return 0
+}
+
+func (d *decoder) uint16() uint16 {
+	return uint16(d.uint(2))
+}
+
+func (d *decoder) uint32() uint32 {
+	return uint32(d.uint(4))
+}
+
+func (d *decoder) offset() uint64 {
+	return d.uint(d.offsetSize)
+}
+
+func (d *decoder) length() uint64 {
+	return d.uint(d.lengthSize)
+}
+
+func (d *decoder) uint(size int) uint64 {
+	b := d.bytes(size)
+
+	var value uint64
+	for index := len(b) - 1; index >= 0; index-- {
+		value = value<<8 | uint64(b[index])
+	}
+
+	return value
+}
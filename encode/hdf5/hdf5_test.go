@@ -0,0 +1,377 @@
+package hdf5
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// "builder" writes a minimal HDF5 file with superblock version 0, 8-byte offsets and 8-byte lengths.
+// Objects are appended from the leaves, and the superblock is written at last.
+type builder struct {
+	b []byte
+}
+
+func newBuilder() *builder {
+	return &builder{b: make([]byte, 96)}
+}
+
+// Append the object aligned to 8 bytes, and return its address.
+func (b *builder) append(object []byte) uint64 {
+	address := uint64(len(b.b))
+
+	b.b = append(b.b, object...)
+	for len(b.b)%8 != 0 {
+		b.b = append(b.b, 0)
+	}
+
+	return address
+}
+
+func (b *builder) bytes(root uint64, btree, heap uint64) []byte {
+	superblock := []byte(signature)
+	superblock = append(superblock, 0, 0, 0, 0, 0, 8, 8, 0)
+	superblock = append(superblock, le(16, 4)...)
+	superblock = append(superblock, le(16, 16)...)
+	superblock = append(superblock, le(32, 0)...)
+	superblock = append(superblock, le(64, 0)...)
+	superblock = append(superblock, le(64, math.MaxUint64)...)
+	superblock = append(superblock, le(64, uint64(len(b.b)))...)
+	superblock = append(superblock, le(64, math.MaxUint64)...)
+	superblock = append(superblock, symbolEntry(0, root, btree, heap)...)
+
+	copy(b.b, superblock)
+
+	return b.b
+}
+
+// Append a group of the given children, and return the addresses of the object header, the B-tree and the heap.
+func (b *builder) group(children map[string]uint64) (header, btree, heap uint64) {
+	names := []string{}
+	for name := range children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data := make([]byte, 8)
+	offsets := []uint64{}
+	for _, name := range names {
+		offsets = append(offsets, uint64(len(data)))
+		data = append(data, name...)
+		for data = append(data, 0); len(data)%8 != 0; {
+			data = append(data, 0)
+		}
+	}
+
+	segment := b.append(data)
+
+	local := []byte("HEAP\x00\x00\x00\x00")
+	local = append(local, le(64, uint64(len(data)))...)
+	local = append(local, le(64, math.MaxUint64)...)
+	local = append(local, le(64, segment)...)
+	heap = b.append(local)
+
+	node := []byte("SNOD\x01\x00")
+	node = append(node, le(16, uint64(len(names)))...)
+	for index, name := range names {
+		node = append(node, symbolEntry(offsets[index], children[name], 0, 0)...)
+	}
+	snod := b.append(node)
+
+	tree := []byte("TREE\x00\x00\x01\x00")
+	tree = append(tree, le(64, math.MaxUint64)...)
+	tree = append(tree, le(64, math.MaxUint64)...)
+	tree = append(tree, le(64, 0)...)
+	tree = append(tree, le(64, snod)...)
+	tree = append(tree, le(64, offsets[len(offsets)-1])...)
+	btree = b.append(tree)
+
+	table := append(le(64, btree), le(64, heap)...)
+	header = b.append(objectHeader(headerMessage(symbolTableMessage, table)))
+
+	return header, btree, heap
+}
+
+func (b *builder) dataset(messages ...[]byte) uint64 {
+	return b.append(objectHeader(messages...))
+}
+
+func le(bits int, value uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, value)
+
+	return b[:bits/8]
+}
+
+func symbolEntry(name, header, btree, heap uint64) []byte {
+	e := append(le(64, name), le(64, header)...)
+
+	if btree == 0 {
+		return append(e, make([]byte, 24)...)
+	}
+
+	e = append(e, le(32, 1)...)
+	e = append(e, le(32, 0)...)
+	e = append(e, le(64, btree)...)
+
+	return append(e, le(64, heap)...)
+}
+
+// Create a header message, whose size includes the padding to 8 bytes.
+func headerMessage(kind uint16, data []byte) []byte {
+	for len(data)%8 != 0 {
+		data = append(data, 0)
+	}
+
+	m := append(le(16, uint64(kind)), le(16, uint64(len(data)))...)
+	m = append(m, 0, 0, 0, 0)
+
+	return append(m, data...)
+}
+
+func objectHeader(messages ...[]byte) []byte {
+	body := []byte{}
+	for _, m := range messages {
+		body = append(body, m...)
+	}
+
+	h := []byte{1, 0}
+	h = append(h, le(16, uint64(len(messages)))...)
+	h = append(h, le(32, 1)...)
+	h = append(h, le(32, uint64(len(body)))...)
+	h = append(h, 0, 0, 0, 0)
+
+	return append(h, body...)
+}
+
+func dataspace(dimensions ...uint64) []byte {
+	d := []byte{1, byte(len(dimensions)), 0, 0, 0, 0, 0, 0}
+	for _, dimension := range dimensions {
+		d = append(d, le(64, dimension)...)
+	}
+
+	return headerMessage(dataspaceMessage, d)
+}
+
+func float64Type() []byte {
+	t := []byte{0x11, 0x20, 0x3f, 0x00}
+	t = append(t, le(32, 8)...)
+	t = append(t, le(16, 0)...)
+	t = append(t, le(16, 64)...)
+	t = append(t, 52, 11, 0, 52)
+	t = append(t, le(32, 1023)...)
+
+	return headerMessage(datatypeMessage, t)
+}
+
+func int32BigEndianType() []byte {
+	t := []byte{0x10, 0x09, 0x00, 0x00}
+	t = append(t, le(32, 4)...)
+	t = append(t, le(16, 0)...)
+	t = append(t, le(16, 32)...)
+
+	return headerMessage(datatypeMessage, t)
+}
+
+func contiguous(address, size uint64) []byte {
+	l := []byte{3, contiguousLayout}
+	l = append(l, le(64, address)...)
+	l = append(l, le(64, size)...)
+
+	return headerMessage(layoutMessage, l)
+}
+
+func compact(data []byte) []byte {
+	l := []byte{3, compactLayout}
+	l = append(l, le(16, uint64(len(data)))...)
+	l = append(l, data...)
+
+	return headerMessage(layoutMessage, l)
+}
+
+func chunked() []byte {
+	return headerMessage(layoutMessage, []byte{3, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0})
+}
+
+// Create a file which has "/matrix", "/vector", "/empty", "/chunked" and "/group/integers".
+func testFile() []byte {
+	b := newBuilder()
+
+	elements := []float64{
+		1, 2.5, -3,
+		4, 5, math.Inf(1),
+	}
+
+	data := []byte{}
+	for _, element := range elements {
+		data = append(data, le(64, math.Float64bits(element))...)
+	}
+
+	matrix := b.dataset(dataspace(2, 3), float64Type(), contiguous(b.append(data), uint64(len(data))))
+	vector := b.dataset(dataspace(3), float64Type(), compact(data[:24]))
+	empty := b.dataset(dataspace(2, 2), float64Type(), contiguous(math.MaxUint64, 32))
+	chunk := b.dataset(dataspace(2, 2), float64Type(), chunked())
+
+	integers := []byte{
+		0x00, 0x00, 0x00, 0x01,
+		0xff, 0xff, 0xff, 0xfe,
+	}
+
+	// The datatype and the layout are placed in a continuation block.
+	block := append(
+		int32BigEndianType(),
+		contiguous(b.append(integers), uint64(len(integers)))...,
+	)
+	continuation := b.append(block)
+
+	nested := b.dataset(
+		dataspace(2, 1),
+		headerMessage(continuationMessage, append(le(64, continuation), le(64, uint64(len(block)))...)),
+	)
+
+	// The header counts the messages in the continuation block too.
+	binary.LittleEndian.PutUint16(b.b[nested+2:], 4)
+
+	group, _, _ := b.group(map[string]uint64{"integers": nested})
+
+	root, btree, heap := b.group(map[string]uint64{
+		"matrix":  matrix,
+		"vector":  vector,
+		"empty":   empty,
+		"chunked": chunk,
+		"group":   group,
+	})
+
+	return b.bytes(root, btree, heap)
+}
+
+type readTest struct {
+	path   string
+	matrix *dense.Matrix
+}
+
+func TestReadReturnsMatrix(t *testing.T) {
+	tests := []*readTest{
+		&readTest{
+			path: "/matrix",
+			matrix: dense.New(2, 3)(
+				1, 2.5, -3,
+				4, 5, math.Inf(1),
+			),
+		},
+		&readTest{
+			path:   "vector",
+			matrix: dense.New(1, 3)(1, 2.5, -3),
+		},
+		&readTest{
+			path:   "/empty",
+			matrix: dense.Zeros(2, 2),
+		},
+		&readTest{
+			path:   "/group/integers",
+			matrix: dense.New(2, 1)(1, -2),
+		},
+	}
+
+	file := testFile()
+
+	for _, test := range tests {
+		m, err := Read(bytes.NewReader(file), test.path)
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred for %s.", err, test.path)
+		}
+
+		if !m.Equal(test.matrix) {
+			t.Fatalf("The matrix read from %s is wrong.", test.path)
+		}
+	}
+}
+
+func TestReadFindsSuperblockAfterUserBlock(t *testing.T) {
+	file := testFile()
+
+	// Addresses are relative to the base address, which is the start of the superblock.
+	binary.LittleEndian.PutUint64(file[24:], 512)
+
+	m, err := Read(bytes.NewReader(append(make([]byte, 512), file...)), "/vector")
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if m.Equal(dense.New(1, 3)(1, 2.5, -3)) {
+		return
+	}
+
+	t.Fatal("The matrix read from the file with user block is wrong.")
+}
+
+func TestDatasetsReturnsAllPaths(t *testing.T) {
+	f, err := Open(bytes.NewReader(testFile()))
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	paths, err := f.Datasets()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	sort.Strings(paths)
+
+	expected := []string{"/chunked", "/empty", "/group/integers", "/matrix", "/vector"}
+
+	if len(paths) != len(expected) {
+		t.Fatalf("The paths should be %v, but are %v.", expected, paths)
+	}
+
+	for index, path := range paths {
+		if path != expected[index] {
+			t.Fatalf("The paths should be %v, but are %v.", expected, paths)
+		}
+	}
+}
+
+type readErrorTest struct {
+	path  string
+	error string
+}
+
+func TestReadFailsForInvalidDataset(t *testing.T) {
+	tests := []*readErrorTest{
+		&readErrorTest{path: "/missing", error: NotFoundError},
+		&readErrorTest{path: "/matrix/child", error: NotFoundError},
+		&readErrorTest{path: "/group", error: NotFoundError},
+		&readErrorTest{path: "/chunked", error: UnsupportedFormatError},
+	}
+
+	file := testFile()
+
+	for _, test := range tests {
+		_, err := Read(bytes.NewReader(file), test.path)
+
+		if err == nil || err.Error() != test.error {
+			t.Fatalf("The error %s should occur for %s, but %v occurred.", test.error, test.path, err)
+		}
+	}
+}
+
+func TestOpenFailsForOtherFiles(t *testing.T) {
+	tests := []*readErrorTest{
+		&readErrorTest{path: "", error: InvalidFormatError},
+		&readErrorTest{path: "\x93NUMPY\x01\x00", error: InvalidFormatError},
+		&readErrorTest{path: signature + "\x02" + string(make([]byte, 120)), error: UnsupportedFormatError},
+		&readErrorTest{path: signature + "\x00", error: InvalidFormatError},
+	}
+
+	for _, test := range tests {
+		_, err := Open(bytes.NewReader([]byte(test.path)))
+
+		if err == nil || err.Error() != test.error {
+			t.Fatalf("The error %s should occur for %q, but %v occurred.", test.error, test.path, err)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Package "imagemat" converts grayscale images to dense matrices of intensities and back.
+*/
+package imagemat
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	// The largest intensity of 16-bit grayscale.
+	maxGray = 0xffff
+)
+
+/*
+"Converter" is a configuration to convert between images and matrices.
+The row of an element is the y coordinate relative to the top of the image,
+and the column is the x coordinate relative to the left.
+*/
+type Converter struct {
+	// "White" is the element corresponding to white, and zero corresponds to black.
+	// For example, 255 reads and writes intensities as 8-bit values.
+	White float64
+
+	// When "Stretch" is true, (*Converter).ToImage maps the minimum element to black
+	// and the maximum element to white instead of using "White".
+	Stretch bool
+}
+
+// Create a new converter with intensities normalized between 0 and 1.
+func NewConverter() *Converter {
+	c := &Converter{
+		White: 1,
+	}
+
+	return c
+}
+
+// Convert the image to a matrix of grayscale intensities with the default converter.
+func FromImage(img image.Image) (*dense.Matrix, error) {
+	return NewConverter().FromImage(img)
+}
+
+// Convert the matrix to a grayscale image with the default converter.
+func ToImage(m types.Matrix) *image.Gray16 {
+	return NewConverter().ToImage(m)
+}
+
+// Convert the image to a matrix of grayscale intensities between 0 and "White".
+// Colors are converted to gray with color.Gray16Model.
+// When the image is empty, dense.NonPositiveSizeError is returned.
+func (c *Converter) FromImage(img image.Image) (*dense.Matrix, error) {
+	bounds := img.Bounds()
+	rows, columns := bounds.Dy(), bounds.Dx()
+
+	if err := dense.CheckShape(rows, columns); err != nil {
+		return nil, err
+	}
+
+	elements := make([]float64, 0, rows*columns)
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			gray := color.Gray16Model.Convert(img.At(x, y)).(color.Gray16)
+			elements = append(elements, float64(gray.Y)/maxGray*c.White)
+		}
+	}
+
+	return dense.New(rows, columns)(elements...), nil
+}
+
+// Convert the matrix to a 16-bit grayscale image.
+// Elements are scaled from the range between 0 and "White", or the range of elements if "Stretch" is true,
+// and elements out of the range are clipped.
+// NaN is written as black.
+func (c *Converter) ToImage(m types.Matrix) *image.Gray16 {
+	rows, columns := m.Shape()
+
+	black, white := 0.0, c.White
+	if c.Stretch {
+		black, white = c.bounds(m)
+	}
+
+	img := image.NewGray16(image.Rect(0, 0, columns, rows))
+
+	cursor := m.All()
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		img.SetGray16(column, row, color.Gray16{Y: c.gray(element, black, white)})
+	}
+
+	return img
+}
+
+// Find the minimum and maximum elements except NaN.
+func (c *Converter) bounds(m types.Matrix) (min, max float64) {
+	min, max = math.Inf(1), math.Inf(-1)
+
+	cursor := m.All()
+	for cursor.HasNext() {
+		element, _, _ := cursor.Get()
+
+		if math.IsNaN(element) {
+			continue
+		}
+
+		min, max = math.Min(min, element), math.Max(max, element)
+	}
+
+	return min, max
+}
+
+// Scale the element between "black" and "white" to 16-bit intensity.
+func (c *Converter) gray(element, black, white float64) uint16 {
+	if math.IsNaN(element) || !(black < white) {
+		return 0
+	}
+
+	scaled := (element - black) / (white - black) * maxGray
+
+	switch {
+	case scaled <= 0:
+		return 0
+	case scaled >= maxGray:
+		return maxGray
+	}
+
+	return uint16(scaled + 0.5)
+}
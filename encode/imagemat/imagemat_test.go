@@ -0,0 +1,90 @@
+package imagemat
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestFromImageReturnsIntensities(t *testing.T) {
+	img := image.NewGray(image.Rect(10, 20, 13, 22))
+	img.SetGray(10, 20, color.Gray{Y: 0})
+	img.SetGray(11, 20, color.Gray{Y: 51})
+	img.SetGray(12, 20, color.Gray{Y: 255})
+	img.SetGray(10, 21, color.Gray{Y: 102})
+	img.SetGray(11, 21, color.Gray{Y: 153})
+	img.SetGray(12, 21, color.Gray{Y: 204})
+
+	c := NewConverter()
+	c.White = 255
+
+	m, err := c.FromImage(img)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	r := dense.New(2, 3)(
+		0, 51, 255,
+		102, 153, 204,
+	)
+
+	if m.EqualApprox(r, 1e-9) {
+		return
+	}
+
+	t.Fatal("The intensities read from the image are wrong.")
+}
+
+func TestFromImageFailsForEmptyImage(t *testing.T) {
+	_, err := FromImage(image.NewGray(image.Rect(0, 0, 0, 3)))
+
+	if err != nil && err.Error() == dense.NonPositiveSizeError {
+		return
+	}
+
+	t.Fatalf("The empty image should cause %s.", dense.NonPositiveSizeError)
+}
+
+func TestToImageRestoresImage(t *testing.T) {
+	m := dense.New(2, 2)(
+		0, 0.25,
+		0.5, 1,
+	)
+
+	n, err := FromImage(ToImage(m))
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if n.EqualApprox(m, 1.0/maxGray) {
+		return
+	}
+
+	t.Fatal("The matrix should be restored from the converted image.")
+}
+
+func TestToImageClipsElements(t *testing.T) {
+	img := ToImage(dense.New(1, 3)(-1, 2, math.NaN()))
+
+	for x, y := range []uint16{0, maxGray, 0} {
+		if gray := img.Gray16At(x, 0).Y; gray != y {
+			t.Fatalf("The pixel at %d should be %d, but is %d.", x, y, gray)
+		}
+	}
+}
+
+func TestToImageWithStretchUsesRangeOfElements(t *testing.T) {
+	c := NewConverter()
+	c.Stretch = true
+
+	img := c.ToImage(dense.New(1, 3)(-2, 0, 2))
+
+	for x, y := range []uint16{0, maxGray/2 + 1, maxGray} {
+		if gray := img.Gray16At(x, 0).Y; gray != y {
+			t.Fatalf("The pixel at %d should be %d, but is %d.", x, y, gray)
+		}
+	}
+}
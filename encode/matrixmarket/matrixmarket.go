@@ -0,0 +1,321 @@
+/*
+Package "matrixmarket" reads and writes dense matrices in MatrixMarket exchange format.
+Both of coordinate (sparse) and array (dense) formats of real matrices are supported.
+*/
+package matrixmarket
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	InvalidHeaderError     = "InvalidHeaderError"
+	UnsupportedFormatError = "UnsupportedFormatError"
+	InvalidSizeError       = "InvalidSizeError"
+	InvalidEntryError      = "InvalidEntryError"
+)
+
+const (
+	// The maximum number of elements of matrices read from coordinate format.
+	// Coordinate files declare the size without storing every element,
+	// so larger sizes are rejected before allocating the dense matrix.
+	MaxCoordinateElements = 1 << 28
+)
+
+const (
+	banner = "%%MatrixMarket"
+)
+
+/*
+"header" is the kind of matrix declared by the banner line.
+*/
+type header struct {
+	format   string
+	field    string
+	symmetry string
+}
+
+// Read a matrix in MatrixMarket format.
+// The field should be "real", "integer" or "pattern",
+// and the symmetry should be "general", "symmetric" or "skew-symmetric".
+// When the banner is malformed, InvalidHeaderError is returned,
+// when the matrix is complex or Hermitian, UnsupportedFormatError is returned,
+// and when a matrix in coordinate format has more than MaxCoordinateElements elements, InvalidSizeError is returned.
+func Read(r io.Reader) (*dense.Matrix, error) {
+	scanner := bufio.NewScanner(r)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+
+		return nil, errors.New(InvalidHeaderError)
+	}
+
+	h, err := parseHeader(scanner.Text())
+	if err != nil {
+		return nil, err
+	}
+
+	// The remaining lines except comments and blank lines are tokenized.
+	tokens := []string{}
+
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "%") {
+			continue
+		}
+
+		tokens = append(tokens, strings.Fields(text)...)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if h.format == "coordinate" {
+		return readCoordinate(h, tokens)
+	}
+
+	return readArray(h, tokens)
+}
+
+func parseHeader(text string) (*header, error) {
+	fields := strings.Fields(strings.ToLower(text))
+
+	if len(fields) != 5 || fields[0] != strings.ToLower(banner) || fields[1] != "matrix" {
+		return nil, errors.New(InvalidHeaderError)
+	}
+
+	h := &header{
+		format:   fields[2],
+		field:    fields[3],
+		symmetry: fields[4],
+	}
+
+	switch h.format {
+	case "coordinate", "array":
+	default:
+		return nil, errors.New(InvalidHeaderError)
+	}
+
+	switch h.field {
+	case "real", "integer":
+	case "pattern":
+		if h.format == "array" {
+			return nil, errors.New(InvalidHeaderError)
+		}
+	default:
+		return nil, errors.New(UnsupportedFormatError)
+	}
+
+	switch h.symmetry {
+	case "general", "symmetric", "skew-symmetric":
+	default:
+		return nil, errors.New(UnsupportedFormatError)
+	}
+
+	return h, nil
+}
+
+// Parse the first "count" tokens as positive integers.
+func parseSize(tokens []string, count int) ([]int, error) {
+	if len(tokens) < count {
+		return nil, errors.New(InvalidSizeError)
+	}
+
+	size := make([]int, count)
+
+	for i := range size {
+		n, err := strconv.Atoi(tokens[i])
+		if err != nil || n < 0 {
+			return nil, errors.New(InvalidSizeError)
+		}
+
+		size[i] = n
+	}
+
+	if err := dense.CheckShape(size[0], size[1]); err != nil {
+		return nil, err
+	}
+
+	return size, nil
+}
+
+func readCoordinate(h *header, tokens []string) (*dense.Matrix, error) {
+	size, err := parseSize(tokens, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, columns, entries := size[0], size[1], size[2]
+	tokens = tokens[3:]
+
+	if rows*columns > MaxCoordinateElements {
+		return nil, errors.New(InvalidSizeError)
+	}
+
+	width := 3
+	if h.field == "pattern" {
+		width = 2
+	}
+
+	if len(tokens) != entries*width {
+		return nil, errors.New(InvalidEntryError)
+	}
+
+	m := dense.Zeros(rows, columns)
+
+	for ; len(tokens) > 0; tokens = tokens[width:] {
+		row, rowErr := strconv.Atoi(tokens[0])
+		column, columnErr := strconv.Atoi(tokens[1])
+
+		if rowErr != nil || columnErr != nil || row < 1 || rows < row || column < 1 || columns < column {
+			return nil, errors.New(InvalidEntryError)
+		}
+
+		element := 1.0
+
+		if width == 3 {
+			element, err = strconv.ParseFloat(tokens[2], 64)
+			if err != nil {
+				return nil, errors.New(InvalidEntryError)
+			}
+		}
+
+		if err := set(h, m, row-1, column-1, element); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+func readArray(h *header, tokens []string) (*dense.Matrix, error) {
+	size, err := parseSize(tokens, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, columns := size[0], size[1]
+	tokens = tokens[2:]
+
+	if h.symmetry != "general" && rows != columns {
+		return nil, errors.New(InvalidSizeError)
+	}
+
+	m := dense.Zeros(rows, columns)
+
+	// The elements are listed in column-major order,
+	// and only the lower triangle is listed for symmetric matrices.
+	for column := 0; column < columns; column++ {
+		row := 0
+
+		switch h.symmetry {
+		case "symmetric":
+			row = column
+		case "skew-symmetric":
+			row = column + 1
+		}
+
+		for ; row < rows; row++ {
+			if len(tokens) == 0 {
+				return nil, errors.New(InvalidEntryError)
+			}
+
+			element, err := strconv.ParseFloat(tokens[0], 64)
+			if err != nil {
+				return nil, errors.New(InvalidEntryError)
+			}
+
+			tokens = tokens[1:]
+
+			if err := set(h, m, row, column, element); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(tokens) != 0 {
+		return nil, errors.New(InvalidEntryError)
+	}
+
+	return m, nil
+}
+
+// Set the element and its mirror for symmetric matrices.
+func set(h *header, m *dense.Matrix, row, column int, element float64) error {
+	m.Update(row, column, element)
+
+	switch h.symmetry {
+	case "symmetric":
+		m.Update(column, row, element)
+	case "skew-symmetric":
+		if row == column {
+			return errors.New(InvalidEntryError)
+		}
+
+		m.Update(column, row, -element)
+	}
+
+	return nil
+}
+
+// Write "m" in coordinate format of general real matrix.
+// Only non-zero elements are written.
+func WriteCoordinate(w io.Writer, m types.Matrix) error {
+	rows, columns := m.Shape()
+
+	entries := 0
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		cursor.Get()
+		entries++
+	}
+
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintf(writer, "%s matrix coordinate real general\n", banner)
+	fmt.Fprintf(writer, "%d %d %d\n", rows, columns, entries)
+
+	cursor = m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		fmt.Fprintf(writer, "%d %d %s\n", row+1, column+1, format(element))
+	}
+
+	return writer.Flush()
+}
+
+// Write "m" in array format of general real matrix.
+func WriteArray(w io.Writer, m types.Matrix) error {
+	rows, columns := m.Shape()
+
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintf(writer, "%s matrix array real general\n", banner)
+	fmt.Fprintf(writer, "%d %d\n", rows, columns)
+
+	for column := 0; column < columns; column++ {
+		for row := 0; row < rows; row++ {
+			fmt.Fprintf(writer, "%s\n", format(m.Get(row, column)))
+		}
+	}
+
+	return writer.Flush()
+}
+
+func format(element float64) string {
+	return strconv.FormatFloat(element, 'g', -1, 64)
+}
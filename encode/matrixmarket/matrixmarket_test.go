@@ -0,0 +1,180 @@
+package matrixmarket
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+type readTest struct {
+	text   string
+	matrix *dense.Matrix
+}
+
+func TestReadReturnsMatrix(t *testing.T) {
+	tests := []*readTest{
+		&readTest{
+			text: "%%MatrixMarket matrix coordinate real general\n" +
+				"% comment\n" +
+				"2 3 3\n" +
+				"1 1 1.5\n" +
+				"2 3 -2\n" +
+				"1 2 4e1\n",
+			matrix: dense.New(2, 3)(
+				1.5, 40, 0,
+				0, 0, -2,
+			),
+		},
+		&readTest{
+			text: "%%MatrixMarket matrix coordinate pattern symmetric\n" +
+				"3 3 2\n" +
+				"2 1\n" +
+				"3 3\n",
+			matrix: dense.New(3, 3)(
+				0, 1, 0,
+				1, 0, 0,
+				0, 0, 1,
+			),
+		},
+		&readTest{
+			text: "%%MatrixMarket matrix coordinate integer skew-symmetric\n" +
+				"2 2 1\n" +
+				"2 1 3\n",
+			matrix: dense.New(2, 2)(
+				0, -3,
+				3, 0,
+			),
+		},
+		&readTest{
+			text: "%%MatrixMarket matrix array real general\n" +
+				"2 2\n" +
+				"1\n3\n2\n4\n",
+			matrix: dense.New(2, 2)(
+				1, 2,
+				3, 4,
+			),
+		},
+		&readTest{
+			text: "%%MatrixMarket matrix array real symmetric\n" +
+				"2 2\n" +
+				"1\n2\n3\n",
+			matrix: dense.New(2, 2)(
+				1, 2,
+				2, 3,
+			),
+		},
+	}
+
+	for _, test := range tests {
+		m, err := Read(strings.NewReader(test.text))
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred for %q.", err, test.text)
+		}
+
+		if !m.Equal(test.matrix) {
+			t.Fatalf("The matrix read from %q is wrong.", test.text)
+		}
+	}
+}
+
+type readErrorTest struct {
+	text  string
+	error string
+}
+
+func TestReadFailsForInvalidFile(t *testing.T) {
+	tests := []*readErrorTest{
+		&readErrorTest{
+			text:  "",
+			error: InvalidHeaderError,
+		},
+		&readErrorTest{
+			text:  "%%MatrixMarket matrix dense real general\n1 1\n1\n",
+			error: InvalidHeaderError,
+		},
+		&readErrorTest{
+			text:  "%%MatrixMarket matrix coordinate complex general\n1 1 1\n1 1 1 0\n",
+			error: UnsupportedFormatError,
+		},
+		&readErrorTest{
+			text:  "%%MatrixMarket matrix coordinate real general\n1 x 1\n1 1 1\n",
+			error: InvalidSizeError,
+		},
+		&readErrorTest{
+			text:  "%%MatrixMarket matrix coordinate real general\n1000000 1000000 0\n",
+			error: InvalidSizeError,
+		},
+		&readErrorTest{
+			text:  "%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n",
+			error: InvalidEntryError,
+		},
+		&readErrorTest{
+			text:  "%%MatrixMarket matrix coordinate real general\n2 2 2\n1 1 1\n",
+			error: InvalidEntryError,
+		},
+		&readErrorTest{
+			text:  "%%MatrixMarket matrix array real general\n2 1\n1\n2\n3\n",
+			error: InvalidEntryError,
+		},
+	}
+
+	for _, test := range tests {
+		_, err := Read(strings.NewReader(test.text))
+
+		if err == nil || err.Error() != test.error {
+			t.Fatalf("The error %s should occur for %q, but %v occurred.", test.error, test.text, err)
+		}
+	}
+}
+
+func TestWriteCoordinateReturnsFile(t *testing.T) {
+	m := dense.New(2, 2)(
+		0, 1.5,
+		0, 0,
+	)
+
+	buffer := &bytes.Buffer{}
+
+	if err := WriteCoordinate(buffer, m); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	text := "%%MatrixMarket matrix coordinate real general\n" +
+		"2 2 1\n" +
+		"1 2 1.5\n"
+
+	if s := buffer.String(); s != text {
+		t.Fatalf("The file is wrong: %q.", s)
+	}
+}
+
+func TestWriteAndReadReturnsTheOriginal(t *testing.T) {
+	m := dense.New(2, 3)(
+		0.1, 0, 1e-300,
+		0, -7, 1.0/3,
+	).Transpose()
+
+	writers := []func(buffer *bytes.Buffer) error{
+		func(buffer *bytes.Buffer) error { return WriteCoordinate(buffer, m) },
+		func(buffer *bytes.Buffer) error { return WriteArray(buffer, m) },
+	}
+
+	for _, write := range writers {
+		buffer := &bytes.Buffer{}
+
+		if err := write(buffer); err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		n, err := Read(buffer)
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if !n.Equal(m) {
+			t.Fatal("The matrix read from the written file should equal to the original.")
+		}
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Package "npy" reads and writes dense matrices in NumPy .npy format.
+Arrays of float64 and float32 in both of C and Fortran order are supported.
+*/
+package npy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	InvalidMagicError      = "InvalidMagicError"
+	InvalidHeaderError     = "InvalidHeaderError"
+	UnsupportedFormatError = "UnsupportedFormatError"
+)
+
+const (
+	magic = "\x93NUMPY"
+
+	// The length of header including the magic, the version and the length should be aligned to it.
+	alignment = 64
+
+	// The maximum length of headers to read, which are a few KB even for arrays of many dimensions.
+	maxHeaderLength = 1 << 16
+)
+
+var (
+	descrPattern   = regexp.MustCompile(`'descr'\s*:\s*'([^']*)'`)
+	fortranPattern = regexp.MustCompile(`'fortran_order'\s*:\s*(True|False)`)
+	shapePattern   = regexp.MustCompile(`'shape'\s*:\s*\(([^)]*)\)`)
+)
+
+// Read a matrix from .npy format.
+// 2-dimensional arrays are read as they are, and 1-dimensional arrays are read as row vectors.
+// When the dtype is not float64 or float32 or the array has other dimensions,
+// UnsupportedFormatError is returned.
+func Read(r io.Reader) (*dense.Matrix, error) {
+	reader := bufio.NewReader(r)
+
+	prefix := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(reader, prefix); err != nil {
+		return nil, errors.New(InvalidMagicError)
+	}
+
+	if string(prefix[:len(magic)]) != magic {
+		return nil, errors.New(InvalidMagicError)
+	}
+
+	var length int
+
+	switch major := prefix[len(magic)]; major {
+	case 1:
+		var l uint16
+		if err := binary.Read(reader, binary.LittleEndian, &l); err != nil {
+			return nil, errors.New(InvalidHeaderError)
+		}
+		length = int(l)
+	case 2, 3:
+		var l uint32
+		if err := binary.Read(reader, binary.LittleEndian, &l); err != nil {
+			return nil, errors.New(InvalidHeaderError)
+		}
+		length = int(l)
+	default:
+		return nil, errors.New(UnsupportedFormatError)
+	}
+
+	// The length is validated before the header is allocated.
+	if length > maxHeaderLength {
+		return nil, errors.New(InvalidHeaderError)
+	}
+
+	header := make([]byte, length)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, errors.New(InvalidHeaderError)
+	}
+
+	order, size, fortran, rows, columns, err := parseHeader(string(header))
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([]float64, rows*columns)
+
+	if size == 4 {
+		values := make([]float32, len(elements))
+		if err := binary.Read(reader, order, values); err != nil {
+			return nil, err
+		}
+
+		for i, value := range values {
+			elements[i] = float64(value)
+		}
+	} else {
+		if err := binary.Read(reader, order, elements); err != nil {
+			return nil, err
+		}
+	}
+
+	if fortran {
+		// The elements are laid out as the transpose in C order.
+		return dense.New(columns, rows)(elements...).TransposeCopy(), nil
+	}
+
+	return dense.New(rows, columns)(elements...), nil
+}
+
+// Parse the header dictionary into the byte order, the size of element, the layout and the shape.
+func parseHeader(header string) (order binary.ByteOrder, size int, fortran bool, rows, columns int, err error) {
+	descr := descrPattern.FindStringSubmatch(header)
+	fortranOrder := fortranPattern.FindStringSubmatch(header)
+	shape := shapePattern.FindStringSubmatch(header)
+
+	if descr == nil || fortranOrder == nil || shape == nil {
+		return nil, 0, false, 0, 0, errors.New(InvalidHeaderError)
+	}
+
+	switch descr[1] {
+	case "<f8", "=f8":
+		order, size = binary.LittleEndian, 8
+	case ">f8":
+		order, size = binary.BigEndian, 8
+	case "<f4", "=f4":
+		order, size = binary.LittleEndian, 4
+	case ">f4":
+		order, size = binary.BigEndian, 4
+	default:
+		return nil, 0, false, 0, 0, errors.New(UnsupportedFormatError)
+	}
+
+	dimensions := []int{}
+
+	for _, field := range strings.Split(shape[1], ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		dimension, err := strconv.Atoi(field)
+		if err != nil || dimension < 0 {
+			return nil, 0, false, 0, 0, errors.New(InvalidHeaderError)
+		}
+
+		dimensions = append(dimensions, dimension)
+	}
+
+	switch len(dimensions) {
+	case 1:
+		rows, columns = 1, dimensions[0]
+	case 2:
+		rows, columns = dimensions[0], dimensions[1]
+	default:
+		return nil, 0, false, 0, 0, errors.New(UnsupportedFormatError)
+	}
+
+	if err := dense.CheckShape(rows, columns); err != nil {
+		return nil, 0, false, 0, 0, err
+	}
+
+	return order, size, fortranOrder[1] == "True", rows, columns, nil
+}
+
+/*
+"Writer" writes a matrix in .npy format of version 1.0.
+*/
+type Writer struct {
+	writer io.Writer
+
+	// When "Float32" is true, the elements are written as little-endian float32 instead of float64.
+	Float32 bool
+
+	// When "FortranOrder" is true, the elements are written in column-major order.
+	FortranOrder bool
+}
+
+// Create a new writer for .npy written to "w".
+// The elements are written as little-endian float64 in C order by default.
+func NewWriter(w io.Writer) *Writer {
+	writer := &Writer{
+		writer: w,
+	}
+
+	return writer
+}
+
+// Write "m" as a 2-dimensional array.
+func (w *Writer) Write(m types.Matrix) error {
+	rows, columns := m.Shape()
+
+	descr := "<f8"
+	if w.Float32 {
+		descr = "<f4"
+	}
+
+	fortran := "False"
+	if w.FortranOrder {
+		fortran = "True"
+	}
+
+	header := fmt.Sprintf(
+		"{'descr': '%s', 'fortran_order': %s, 'shape': (%d, %d), }",
+		descr,
+		fortran,
+		rows,
+		columns,
+	)
+
+	// The header is padded with spaces and terminated by a newline.
+	prefix := len(magic) + 2 + 2
+	padding := alignment - (prefix+len(header)+1)%alignment
+	if padding == alignment {
+		padding = 0
+	}
+	header += strings.Repeat(" ", padding) + "\n"
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteString(magic)
+	buffer.Write([]byte{1, 0})
+	binary.Write(buffer, binary.LittleEndian, uint16(len(header)))
+	buffer.WriteString(header)
+
+	if w.FortranOrder {
+		m = m.Transpose()
+		rows, columns = columns, rows
+	}
+
+	elements := make([]float64, 0, rows*columns)
+
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			elements = append(elements, m.Get(row, column))
+		}
+	}
+
+	if w.Float32 {
+		values := make([]float32, len(elements))
+		for i, element := range elements {
+			values[i] = float32(element)
+		}
+
+		binary.Write(buffer, binary.LittleEndian, values)
+	} else {
+		binary.Write(buffer, binary.LittleEndian, elements)
+	}
+
+	_, err := buffer.WriteTo(w.writer)
+
+	return err
+}
+
+// Write "m" as a 2-dimensional array of little-endian float64 in C order.
+func Write(w io.Writer, m types.Matrix) error {
+	return NewWriter(w).Write(m)
+}
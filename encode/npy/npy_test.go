@@ -0,0 +1,161 @@
+package npy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// Create the bytes of .npy file of version 1.0 with the given header and elements.
+func npyFile(header string, order binary.ByteOrder, elements interface{}) []byte {
+	buffer := &bytes.Buffer{}
+	buffer.WriteString(magic)
+	buffer.Write([]byte{1, 0})
+	binary.Write(buffer, binary.LittleEndian, uint16(len(header)))
+	buffer.WriteString(header)
+	binary.Write(buffer, order, elements)
+
+	return buffer.Bytes()
+}
+
+type readTest struct {
+	file   []byte
+	matrix *dense.Matrix
+}
+
+func TestReadReturnsMatrix(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		4, 5, 6.5,
+	)
+
+	tests := []*readTest{
+		&readTest{
+			file: npyFile(
+				"{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }"+strings.Repeat(" ", 63)+"\n",
+				binary.LittleEndian,
+				[]float64{1, 2, 3, 4, 5, 6.5},
+			),
+			matrix: m,
+		},
+		&readTest{
+			file: npyFile(
+				"{'descr': '>f4', 'fortran_order': True, 'shape': (2, 3), }\n",
+				binary.BigEndian,
+				[]float32{1, 4, 2, 5, 3, 6.5},
+			),
+			matrix: m,
+		},
+		&readTest{
+			file: npyFile(
+				"{'descr': '<f8', 'fortran_order': False, 'shape': (3,), }\n",
+				binary.LittleEndian,
+				[]float64{1, 2, 3},
+			),
+			matrix: dense.New(1, 3)(1, 2, 3),
+		},
+	}
+
+	for _, test := range tests {
+		n, err := Read(bytes.NewReader(test.file))
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if !n.Equal(test.matrix) {
+			t.Fatal("The matrix read from .npy is wrong.")
+		}
+	}
+}
+
+type readErrorTest struct {
+	file  []byte
+	error string
+}
+
+func TestReadFailsForInvalidFile(t *testing.T) {
+	tests := []*readErrorTest{
+		&readErrorTest{
+			file:  []byte("NUMPY"),
+			error: InvalidMagicError,
+		},
+		&readErrorTest{
+			file:  npyFile("{'descr': '<f8', 'shape': (1, 1), }\n", binary.LittleEndian, []float64{1}),
+			error: InvalidHeaderError,
+		},
+		&readErrorTest{
+			file:  []byte(magic + "\x02\x00\xff\xff\xff\xff"),
+			error: InvalidHeaderError,
+		},
+		&readErrorTest{
+			file:  npyFile("{'descr': '<i8', 'fortran_order': False, 'shape': (1, 1), }\n", binary.LittleEndian, []int64{1}),
+			error: UnsupportedFormatError,
+		},
+		&readErrorTest{
+			file:  npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (1, 1, 1), }\n", binary.LittleEndian, []float64{1}),
+			error: UnsupportedFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		_, err := Read(bytes.NewReader(test.file))
+
+		if err == nil || err.Error() != test.error {
+			t.Fatalf("The error %s should occur, but %v occurred.", test.error, err)
+		}
+	}
+}
+
+func TestWriteAlignsHeader(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	if err := Write(buffer, dense.Zeros(2, 3)); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	b := buffer.Bytes()
+	length := int(binary.LittleEndian.Uint16(b[8:10]))
+
+	if (10+length)%alignment != 0 || b[9+length] != '\n' {
+		t.Fatal("The header should be terminated by a newline and aligned.")
+	}
+
+	if len(b) != 10+length+2*3*8 {
+		t.Fatalf("The length of file should be %d, but is %d.", 10+length+2*3*8, len(b))
+	}
+}
+
+func TestWriteAndReadReturnsTheOriginal(t *testing.T) {
+	m := dense.New(2, 3)(
+		0.5, -1, 0,
+		2, 0.25, 8,
+	).Transpose()
+
+	writers := []*Writer{
+		&Writer{},
+		&Writer{Float32: true},
+		&Writer{FortranOrder: true},
+		&Writer{Float32: true, FortranOrder: true},
+	}
+
+	for _, writer := range writers {
+		buffer := &bytes.Buffer{}
+		writer.writer = buffer
+
+		if err := writer.Write(m); err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		n, err := Read(buffer)
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if !n.Equal(m) {
+			t.Fatal("The matrix read from the written .npy should equal to the original.")
+		}
+	}
+}
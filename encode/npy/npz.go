@@ -0,0 +1,80 @@
+package npy
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	extension = ".npy"
+)
+
+// Read the matrices in .npz archive, which is a zip archive of .npy files, keyed by the names without extension.
+// Both of stored and deflated archives are read, as written by numpy.savez and numpy.savez_compressed.
+// When the archive contains a file other than .npy, UnsupportedFormatError is returned.
+func ReadArchive(r io.ReaderAt, size int64) (map[string]types.Matrix, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	matrices := make(map[string]types.Matrix, len(archive.File))
+
+	for _, file := range archive.File {
+		if !strings.HasSuffix(file.Name, extension) {
+			return nil, errors.New(UnsupportedFormatError)
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := Read(reader)
+		reader.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		matrices[strings.TrimSuffix(file.Name, extension)] = m
+	}
+
+	return matrices, nil
+}
+
+// Write "matrices" as .npz archive, storing each matrix as the .npy file named with its key.
+// The files are written in the order of names, and not compressed as numpy.savez does.
+func WriteArchive(w io.Writer, matrices map[string]types.Matrix) error {
+	names := make([]string, 0, len(matrices))
+	for name := range matrices {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	archive := zip.NewWriter(w)
+
+	for _, name := range names {
+		header := &zip.FileHeader{
+			Name:   name + extension,
+			Method: zip.Store,
+		}
+
+		file, err := archive.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if err := Write(file, matrices[name]); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
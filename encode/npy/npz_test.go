@@ -0,0 +1,82 @@
+package npy
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+func TestWriteArchiveAndReadArchiveReturnsTheOriginals(t *testing.T) {
+	matrices := map[string]types.Matrix{
+		"weights": dense.New(2, 3)(
+			1, 2, 3,
+			4, 5, 6,
+		),
+		"bias": dense.New(1, 3)(0.5, -0.5, 0),
+		"x_train": dense.New(2, 2)(
+			1, 0,
+			0, 1,
+		).Transpose(),
+	}
+
+	buffer := &bytes.Buffer{}
+
+	if err := WriteArchive(buffer, matrices); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	read, err := ReadArchive(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if len(read) != len(matrices) {
+		t.Fatalf("The number of matrices should be %d, but is %d.", len(matrices), len(read))
+	}
+
+	for name, m := range matrices {
+		if n, exist := read[name]; !exist || !n.Equal(m) {
+			t.Fatalf("The matrix %q should equal to the original.", name)
+		}
+	}
+}
+
+func TestReadArchiveOfDeflated(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	archive := zip.NewWriter(buffer)
+
+	file, _ := archive.CreateHeader(&zip.FileHeader{Name: "a.npy", Method: zip.Deflate})
+	Write(file, dense.New(1, 2)(3, 4))
+	archive.Close()
+
+	read, err := ReadArchive(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if m, exist := read["a"]; exist && m.Equal(dense.New(1, 2)(3, 4)) {
+		return
+	}
+
+	t.Fatal("The matrix in the deflated archive should be read.")
+}
+
+func TestReadArchiveFailsForOtherFiles(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	archive := zip.NewWriter(buffer)
+
+	file, _ := archive.Create("README.txt")
+	file.Write([]byte("not a matrix"))
+	archive.Close()
+
+	_, err := ReadArchive(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+
+	if err != nil && err.Error() == UnsupportedFormatError {
+		return
+	}
+
+	t.Fatalf("The error %s should occur, but %v occurred.", UnsupportedFormatError, err)
+}
@@ -0,0 +1,141 @@
+/*
+Package "sqlmat" reads dense matrices from rows of database/sql.
+*/
+package sqlmat
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+const (
+	EmptyError = "EmptyError"
+)
+
+/*
+"ElementError" is returned by (*Reader).Read
+when a value cannot be converted to a floating-point number.
+*/
+type ElementError struct {
+	Row    int
+	Column string
+	Value  interface{}
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("value of %q in row %d is not a number: %#v", e.Column, e.Row, e.Value)
+}
+
+/*
+"Reader" reads a matrix from the result of a query.
+Each row of the result becomes a row of the matrix, and each column a column.
+*/
+type Reader struct {
+	rows *sql.Rows
+
+	// "Missing" is the element read from NULL, e.g. math.NaN().
+	Missing float64
+}
+
+// Create a new reader for "rows".
+// NULL is read as zero by default.
+func NewReader(rows *sql.Rows) *Reader {
+	reader := &Reader{
+		rows: rows,
+	}
+
+	return reader
+}
+
+// Read all rows as a new matrix with the default reader.
+func Read(rows *sql.Rows) (m *dense.Matrix, columns []string, err error) {
+	return NewReader(rows).Read()
+}
+
+// Read all rows as a new matrix, and return the names of columns.
+// Integers, floating-point numbers, booleans and numeric text are accepted.
+// The rows are not closed, so the caller should close them.
+// When no row is found, EmptyError is returned,
+// and when a value is not numeric, *ElementError is returned.
+func (r *Reader) Read() (m *dense.Matrix, columns []string, err error) {
+	columns, err = r.rows.Columns()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := make([]interface{}, len(columns))
+	pointers := make([]interface{}, len(columns))
+	for index := range values {
+		pointers[index] = &values[index]
+	}
+
+	elements := []float64{}
+	rows := 0
+
+	for r.rows.Next() {
+		if err := r.rows.Scan(pointers...); err != nil {
+			return nil, nil, err
+		}
+
+		for index, value := range values {
+			element, ok := r.convert(value)
+			if !ok {
+				return nil, nil, &ElementError{Row: rows, Column: columns[index], Value: value}
+			}
+
+			elements = append(elements, element)
+		}
+
+		rows++
+	}
+
+	if err := r.rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if rows == 0 || len(columns) == 0 {
+		return nil, nil, errors.New(EmptyError)
+	}
+
+	if err := dense.CheckShape(rows, len(columns)); err != nil {
+		return nil, nil, err
+	}
+
+	return dense.New(rows, len(columns))(elements...), columns, nil
+}
+
+// Convert a value scanned by database/sql to an element.
+func (r *Reader) convert(value interface{}) (element float64, ok bool) {
+	switch v := value.(type) {
+	case nil:
+		return r.Missing, true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case []byte:
+		return r.parse(string(v))
+	case string:
+		return r.parse(v)
+	}
+
+	return 0, false
+}
+
+func (r *Reader) parse(text string) (element float64, ok bool) {
+	element, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return element, true
+}
@@ -0,0 +1,194 @@
+package sqlmat
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// A driver returning fixed rows for the query, which is the name of a table in "tables".
+type tableDriver struct{}
+
+type table struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+var tables = map[string]*table{
+	"points": &table{
+		columns: []string{"x", "y", "z"},
+		values: [][]driver.Value{
+			{int64(1), 2.5, []byte("-3")},
+			{"4", nil, true},
+		},
+	},
+	"names": &table{
+		columns: []string{"id", "name"},
+		values: [][]driver.Value{
+			{int64(1), "one"},
+		},
+	},
+	"empty": &table{
+		columns: []string{"x"},
+	},
+}
+
+func init() {
+	sql.Register("sqlmat", &tableDriver{})
+}
+
+func (d *tableDriver) Open(name string) (driver.Conn, error) {
+	return &tableConn{}, nil
+}
+
+type tableConn struct{}
+
+func (c *tableConn) Prepare(query string) (driver.Stmt, error) {
+	t, exist := tables[query]
+	if !exist {
+		return nil, errors.New("no such table")
+	}
+
+	return &tableStmt{table: t}, nil
+}
+
+func (c *tableConn) Close() error {
+	return nil
+}
+
+func (c *tableConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+type tableStmt struct {
+	table *table
+}
+
+func (s *tableStmt) Close() error {
+	return nil
+}
+
+func (s *tableStmt) NumInput() int {
+	return 0
+}
+
+func (s *tableStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *tableStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &tableRows{table: s.table}, nil
+}
+
+type tableRows struct {
+	table *table
+	index int
+}
+
+func (r *tableRows) Columns() []string {
+	return r.table.columns
+}
+
+func (r *tableRows) Close() error {
+	return nil
+}
+
+func (r *tableRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.table.values) {
+		return io.EOF
+	}
+
+	copy(dest, r.table.values[r.index])
+	r.index++
+
+	return nil
+}
+
+func query(t *testing.T, name string) *sql.Rows {
+	db, err := sql.Open("sqlmat", "")
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	rows, err := db.Query(name)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	return rows
+}
+
+func TestReadReturnsMatrixAndColumns(t *testing.T) {
+	rows := query(t, "points")
+	defer rows.Close()
+
+	m, columns, err := Read(rows)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if len(columns) != 3 || columns[0] != "x" || columns[1] != "y" || columns[2] != "z" {
+		t.Fatalf("The columns should be [x y z], but are %v.", columns)
+	}
+
+	r := dense.New(2, 3)(
+		1, 2.5, -3,
+		4, 0, 1,
+	)
+
+	if m.Equal(r) {
+		return
+	}
+
+	t.Fatal("The matrix read from rows is wrong.")
+}
+
+func TestReadWithMissingReplacesNull(t *testing.T) {
+	rows := query(t, "points")
+	defer rows.Close()
+
+	reader := NewReader(rows)
+	reader.Missing = math.NaN()
+
+	m, _, err := reader.Read()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if math.IsNaN(m.Get(1, 1)) {
+		return
+	}
+
+	t.Fatal("NULL should be read as the missing element.")
+}
+
+func TestReadFailsForNonNumericValue(t *testing.T) {
+	rows := query(t, "names")
+	defer rows.Close()
+
+	_, _, err := Read(rows)
+
+	if e, ok := err.(*ElementError); ok && e.Row == 0 && e.Column == "name" {
+		return
+	}
+
+	t.Fatalf("The non-numeric value should cause *ElementError, but %v occurred.", err)
+}
+
+func TestReadFailsForEmptyRows(t *testing.T) {
+	rows := query(t, "empty")
+	defer rows.Close()
+
+	_, _, err := Read(rows)
+
+	if err != nil && err.Error() == EmptyError {
+		return
+	}
+
+	t.Fatalf("The empty rows should cause %s.", EmptyError)
+}
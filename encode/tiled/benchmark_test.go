@@ -0,0 +1,46 @@
+package tiled
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func benchmarkWrite(b *testing.B, workers int) {
+	m := sequence(512, 512)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Write(ioutil.Discard, m, 64, 64, workers)
+	}
+}
+
+func benchmarkRead(b *testing.B, workers int) {
+	buffer := &bytes.Buffer{}
+	Write(buffer, sequence(512, 512), 64, 64, 0)
+
+	r := bytes.NewReader(buffer.Bytes())
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Read(r, workers)
+	}
+}
+
+func BenchmarkWriteSingle(b *testing.B) {
+	benchmarkWrite(b, 1)
+}
+
+func BenchmarkWriteParallel(b *testing.B) {
+	benchmarkWrite(b, 0)
+}
+
+func BenchmarkReadSingle(b *testing.B) {
+	benchmarkRead(b, 1)
+}
+
+func BenchmarkReadParallel(b *testing.B) {
+	benchmarkRead(b, 0)
+}
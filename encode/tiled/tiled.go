@@ -0,0 +1,374 @@
+/*
+Package "tiled" reads and writes dense matrices in a tiled binary format with an index of tiles,
+so that a large matrix is encoded and decoded by multiple goroutines,
+and an arbitrary tile can be read without reading the others.
+
+The format consists of the header, the index and the tiles:
+
+	magic        8 bytes  "MTXTILE1"
+	rows         uint64
+	columns      uint64
+	tile rows    uint64
+	tile columns uint64
+	tiles        uint64
+	index        (offset uint64, length uint64) for each tile
+	tiles        little-endian float64 elements of each tile in row-major order
+
+All integers are little-endian, and the offsets are from the beginning of the file.
+The tiles are ordered in row-major order of the grid of tiles.
+*/
+package tiled
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"runtime"
+	"sync"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	InvalidMagicError = "InvalidMagicError"
+	InvalidIndexError = "InvalidIndexError"
+)
+
+const (
+	magic = "MTXTILE1"
+
+	// The size of the fixed part of the header in bytes.
+	fixedSize = len(magic) + 5*8
+
+	// The size of an entry of the index in bytes.
+	entrySize = 2 * 8
+)
+
+/*
+"Tile" is a rectangular region of the matrix and its location in the file.
+*/
+type Tile struct {
+	Row     int
+	Column  int
+	Rows    int
+	Columns int
+	Offset  int64
+	Length  int64
+}
+
+/*
+"Header" describes the shape of the matrix and the tiles in the file.
+*/
+type Header struct {
+	Rows        int
+	Columns     int
+	TileRows    int
+	TileColumns int
+	Tiles       []*Tile
+}
+
+// Create the header of a "rows x columns" matrix split into tiles of "tileRows x tileColumns".
+// The tiles on the right and bottom edges may be smaller.
+func newHeader(rows, columns, tileRows, tileColumns int) *Header {
+	h := &Header{
+		Rows:        rows,
+		Columns:     columns,
+		TileRows:    tileRows,
+		TileColumns: tileColumns,
+	}
+
+	offset := int64(fixedSize + h.gridRows()*h.gridColumns()*entrySize)
+
+	for row := 0; row < rows; row += tileRows {
+		for column := 0; column < columns; column += tileColumns {
+			t := &Tile{
+				Row:     row,
+				Column:  column,
+				Rows:    minInt(tileRows, rows-row),
+				Columns: minInt(tileColumns, columns-column),
+				Offset:  offset,
+			}
+			t.Length = int64(t.Rows * t.Columns * 8)
+
+			offset += t.Length
+			h.Tiles = append(h.Tiles, t)
+		}
+	}
+
+	return h
+}
+
+func (h *Header) gridRows() int {
+	return (h.Rows + h.TileRows - 1) / h.TileRows
+}
+
+func (h *Header) gridColumns() int {
+	return (h.Columns + h.TileColumns - 1) / h.TileColumns
+}
+
+// Return the index of the tile containing the element at ("row", "column").
+// When "row" or "column" is out of range, validates.OUT_OF_RANGE_PANIC will be caused.
+func (h *Header) TileAt(row, column int) int {
+	validates.IndexShouldBeInRange(h.Rows, h.Columns, row, column)
+
+	return (row/h.TileRows)*h.gridColumns() + column/h.TileColumns
+}
+
+func (h *Header) encode() []byte {
+	buffer := &bytes.Buffer{}
+	buffer.WriteString(magic)
+
+	fixed := []uint64{
+		uint64(h.Rows),
+		uint64(h.Columns),
+		uint64(h.TileRows),
+		uint64(h.TileColumns),
+		uint64(len(h.Tiles)),
+	}
+	binary.Write(buffer, binary.LittleEndian, fixed)
+
+	for _, t := range h.Tiles {
+		binary.Write(buffer, binary.LittleEndian, []uint64{uint64(t.Offset), uint64(t.Length)})
+	}
+
+	return buffer.Bytes()
+}
+
+// Read the header of the tiled matrix from "r".
+// When the file is not in the tiled format, InvalidMagicError is returned,
+// and when the index is inconsistent with the shape, InvalidIndexError is returned.
+func ReadHeader(r io.ReaderAt) (*Header, error) {
+	fixed := make([]byte, fixedSize)
+	if err := readAt(r, fixed, 0); err == io.EOF {
+		return nil, errors.New(InvalidMagicError)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if string(fixed[:len(magic)]) != magic {
+		return nil, errors.New(InvalidMagicError)
+	}
+
+	values := make([]uint64, 5)
+	binary.Read(bytes.NewReader(fixed[len(magic):]), binary.LittleEndian, values)
+
+	for _, value := range values[:4] {
+		if value == 0 || value > math.MaxInt32 {
+			return nil, errors.New(InvalidIndexError)
+		}
+	}
+
+	rows, columns := int(values[0]), int(values[1])
+
+	if err := dense.CheckShape(rows, columns); err != nil {
+		return nil, err
+	}
+
+	h := newHeader(rows, columns, int(values[2]), int(values[3]))
+
+	if values[4] != uint64(len(h.Tiles)) {
+		return nil, errors.New(InvalidIndexError)
+	}
+
+	index := make([]byte, len(h.Tiles)*entrySize)
+	if err := readAt(r, index, int64(fixedSize)); err == io.EOF {
+		return nil, errors.New(InvalidIndexError)
+	} else if err != nil {
+		return nil, err
+	}
+
+	entries := make([]uint64, 2*len(h.Tiles))
+	binary.Read(bytes.NewReader(index), binary.LittleEndian, entries)
+
+	for i, t := range h.Tiles {
+		if entries[2*i+1] != uint64(t.Length) || entries[2*i] > math.MaxInt64 {
+			return nil, errors.New(InvalidIndexError)
+		}
+
+		t.Offset = int64(entries[2*i])
+	}
+
+	return h, nil
+}
+
+// Read the elements of the "i"-th tile in row-major order.
+func (h *Header) ReadTile(r io.ReaderAt, i int) ([]float64, error) {
+	t := h.Tiles[i]
+
+	b := make([]byte, t.Length)
+	if err := readAt(r, b, t.Offset); err != nil {
+		return nil, err
+	}
+
+	elements := make([]float64, t.Rows*t.Columns)
+	for j := range elements {
+		elements[j] = math.Float64frombits(binary.LittleEndian.Uint64(b[8*j:]))
+	}
+
+	return elements, nil
+}
+
+// Write "m" split into tiles of "tileRows x tileColumns".
+// The tiles are encoded by "workers" goroutines, or runtime.NumCPU() when "workers" is not positive,
+// and written in order.
+// When "tileRows" or "tileColumns" is not positive,
+// validates.NON_POSITIVE_SIZE_PANIC will be caused.
+func Write(w io.Writer, m types.Matrix, tileRows, tileColumns, workers int) error {
+	validates.ShapeShouldBePositive(tileRows, tileColumns)
+
+	rows, columns := m.Shape()
+	h := newHeader(rows, columns, tileRows, tileColumns)
+
+	if _, err := w.Write(h.encode()); err != nil {
+		return err
+	}
+
+	workers = workersOf(workers)
+
+	// Each tile is passed from a worker to the writer through its own channel,
+	// and "tokens" bounds the number of tiles encoded but not written yet.
+	encoded := make([]chan []byte, len(h.Tiles))
+	for i := range encoded {
+		encoded[i] = make(chan []byte, 1)
+	}
+
+	tokens := make(chan struct{}, 2*workers)
+	indexes := make(chan int)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(indexes)
+
+		for i := range h.Tiles {
+			select {
+			case tokens <- struct{}{}:
+			case <-done:
+				return
+			}
+
+			indexes <- i
+		}
+	}()
+
+	for worker := 0; worker < workers; worker++ {
+		go func() {
+			for i := range indexes {
+				encoded[i] <- encodeTile(m, h.Tiles[i])
+			}
+		}()
+	}
+
+	for i := range h.Tiles {
+		b := <-encoded[i]
+		<-tokens
+
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeTile(m types.Matrix, t *Tile) []byte {
+	b := make([]byte, t.Length)
+
+	j := 0
+	for row := t.Row; row < t.Row+t.Rows; row++ {
+		for column := t.Column; column < t.Column+t.Columns; column++ {
+			binary.LittleEndian.PutUint64(b[8*j:], math.Float64bits(m.Get(row, column)))
+			j++
+		}
+	}
+
+	return b
+}
+
+// Read a matrix in the tiled format from "r".
+// The tiles are read and decoded by "workers" goroutines, or runtime.NumCPU() when "workers" is not positive.
+func Read(r io.ReaderAt, workers int) (*dense.Matrix, error) {
+	h, err := ReadHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	m := dense.Zeros(h.Rows, h.Columns)
+
+	indexes := make(chan int, len(h.Tiles))
+	for i := range h.Tiles {
+		indexes <- i
+	}
+	close(indexes)
+
+	errs := make(chan error, 1)
+
+	group := &sync.WaitGroup{}
+
+	for worker := workersOf(workers); worker > 0; worker-- {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			for i := range indexes {
+				elements, err := h.ReadTile(r, i)
+				if err != nil {
+					select {
+					case errs <- err:
+					default:
+					}
+
+					return
+				}
+
+				// The tiles are disjoint, so the workers never update the same element.
+				t := h.Tiles[i]
+				for j, element := range elements {
+					m.Update(t.Row+j/t.Columns, t.Column+j%t.Columns, element)
+				}
+			}
+		}()
+	}
+
+	group.Wait()
+
+	select {
+	case err := <-errs:
+		return nil, err
+	default:
+	}
+
+	return m, nil
+}
+
+// Fill "b" from "offset" of "r".
+// io.EOF is ignored when "b" is filled, as io.ReaderAt is allowed to return it at the end.
+func readAt(r io.ReaderAt, b []byte, offset int64) error {
+	n, err := r.ReadAt(b, offset)
+	if n == len(b) && err == io.EOF {
+		return nil
+	}
+
+	return err
+}
+
+func workersOf(workers int) int {
+	if workers > 0 {
+		return workers
+	}
+
+	return runtime.NumCPU()
+}
+
+func minInt(x, y int) int {
+	if x < y {
+		return x
+	}
+
+	return y
+}
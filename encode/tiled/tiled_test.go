@@ -0,0 +1,109 @@
+package tiled
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+// Create a "rows x columns" matrix, the elements of which are distinct.
+func sequence(rows, columns int) *dense.Matrix {
+	elements := make([]float64, rows*columns)
+	for index := range elements {
+		elements[index] = float64(index) + 0.5
+	}
+
+	return dense.New(rows, columns)(elements...)
+}
+
+type tiledTest struct {
+	m           types.Matrix
+	tileRows    int
+	tileColumns int
+	workers     int
+}
+
+func TestWriteAndReadReturnsTheOriginal(t *testing.T) {
+	tests := []*tiledTest{
+		&tiledTest{m: sequence(1, 1), tileRows: 1, tileColumns: 1, workers: 1},
+		&tiledTest{m: sequence(7, 5), tileRows: 3, tileColumns: 2, workers: 4},
+		&tiledTest{m: sequence(7, 5), tileRows: 10, tileColumns: 10, workers: 0},
+		&tiledTest{m: sequence(30, 40).View(3, 4, 20, 25).Transpose(), tileRows: 4, tileColumns: 6, workers: 3},
+	}
+
+	for _, test := range tests {
+		buffer := &bytes.Buffer{}
+
+		if err := Write(buffer, test.m, test.tileRows, test.tileColumns, test.workers); err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		m, err := Read(bytes.NewReader(buffer.Bytes()), test.workers)
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if !m.Equal(test.m) {
+			t.Fatal("The matrix read from the written file should equal to the original.")
+		}
+	}
+}
+
+func TestReadTileReturnsTheRegion(t *testing.T) {
+	m := sequence(7, 5)
+
+	buffer := &bytes.Buffer{}
+
+	if err := Write(buffer, m, 3, 2, 2); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	r := bytes.NewReader(buffer.Bytes())
+
+	h, err := ReadHeader(r)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if len(h.Tiles) != 9 {
+		t.Fatalf("The number of tiles should be 9, but is %d.", len(h.Tiles))
+	}
+
+	i := h.TileAt(6, 4)
+
+	elements, err := h.ReadTile(r, i)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if tile := h.Tiles[i]; tile.Rows != 1 || tile.Columns != 1 || len(elements) != 1 || elements[0] != m.Get(6, 4) {
+		t.Fatal("The tile at the bottom-right corner is wrong.")
+	}
+}
+
+func TestReadFailsForInvalidFile(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	if err := Write(buffer, sequence(4, 4), 2, 2, 1); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	b := buffer.Bytes()
+
+	broken := append([]byte{}, b...)
+	broken[0] = 'X'
+
+	if _, err := Read(bytes.NewReader(broken), 1); err == nil || err.Error() != InvalidMagicError {
+		t.Fatalf("The error %s should occur, but %v occurred.", InvalidMagicError, err)
+	}
+
+	if _, err := Read(bytes.NewReader(b[:len(b)-1]), 2); err == nil {
+		t.Fatal("Reading the truncated file should fail.")
+	}
+
+	if _, err := Read(bytes.NewReader(b[:fixedSize+3]), 2); err == nil || err.Error() != InvalidIndexError {
+		t.Fatalf("The error %s should occur, but %v occurred.", InvalidIndexError, err)
+	}
+}
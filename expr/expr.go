@@ -0,0 +1,146 @@
+/*
+Package "expr" builds element-wise expressions of matrices which are evaluated lazily.
+Operations on an expression only record what to compute,
+and "Eval" computes the result row by row in one pass over the operands,
+so chains of operations such as (A + B) * c don't create intermediate matrices.
+*/
+package expr
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/kernels"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+/*
+"Expression" is an element-wise expression of matrices, which is not evaluated until "Eval" is called.
+The elements of the operands are read when the expression is evaluated,
+so rewriting the operands after building the expression changes the result.
+An expression holds buffers of rows for the evaluation, so it should not be evaluated concurrently.
+*/
+type Expression struct {
+	rows    int
+	columns int
+
+	// Write the elements of the row of the result into "elements".
+	evaluate func(row int, elements []float64)
+}
+
+// Create an expression of the matrix "m" itself.
+// The rows of dense matrices in row-major order are copied from the backing storage directly.
+func Of(m types.Matrix) *Expression {
+	rows, columns := m.Shape()
+
+	evaluate := func(row int, elements []float64) {
+		for column := range elements {
+			elements[column] = m.Get(row, column)
+		}
+	}
+
+	if d, isDense := m.(*dense.Matrix); isDense && d.Order() == dense.RowMajor {
+		g := d.General()
+
+		evaluate = func(row int, elements []float64) {
+			copy(elements, g.Data[row*g.Stride:row*g.Stride+columns])
+		}
+	}
+
+	e := &Expression{
+		rows:     rows,
+		columns:  columns,
+		evaluate: evaluate,
+	}
+
+	return e
+}
+
+// Create an expression of the sum of "m" and "n".
+// When the shapes of "m" and "n" differ, validates.DIFFERENT_SIZE_PANIC will be caused.
+func Add(m, n types.Matrix) *Expression {
+	return Of(m).Add(Of(n))
+}
+
+// Create an expression of the difference of "m" and "n".
+// When the shapes of "m" and "n" differ, validates.DIFFERENT_SIZE_PANIC will be caused.
+func Subtract(m, n types.Matrix) *Expression {
+	return Of(m).Subtract(Of(n))
+}
+
+// Return the shape of the result.
+func (e *Expression) Shape() (rows, columns int) {
+	return e.rows, e.columns
+}
+
+// Return the rows of the result.
+func (e *Expression) Rows() int {
+	return e.rows
+}
+
+// Return the columns of the result.
+func (e *Expression) Columns() int {
+	return e.columns
+}
+
+// Create an expression of the sum of the receiver and "f".
+// When the shapes of the receiver and "f" differ, validates.DIFFERENT_SIZE_PANIC will be caused.
+func (e *Expression) Add(f *Expression) *Expression {
+	return e.combine(f, 1)
+}
+
+// Create an expression of the difference of the receiver and "f".
+// When the shapes of the receiver and "f" differ, validates.DIFFERENT_SIZE_PANIC will be caused.
+func (e *Expression) Subtract(f *Expression) *Expression {
+	return e.combine(f, -1)
+}
+
+// Create an expression of the receiver multiplied by the scalar "s".
+func (e *Expression) Scale(s float64) *Expression {
+	evaluate := func(row int, elements []float64) {
+		e.evaluate(row, elements)
+		kernels.Scale(s, elements)
+	}
+
+	return &Expression{rows: e.rows, columns: e.columns, evaluate: evaluate}
+}
+
+// Create an expression replacing every element of the receiver with the result of "f".
+func (e *Expression) Apply(f func(element float64) float64) *Expression {
+	evaluate := func(row int, elements []float64) {
+		e.evaluate(row, elements)
+
+		for column, element := range elements {
+			elements[column] = f(element)
+		}
+	}
+
+	return &Expression{rows: e.rows, columns: e.columns, evaluate: evaluate}
+}
+
+// Evaluate the expression into a new dense matrix.
+func (e *Expression) Eval() *dense.Matrix {
+	r := dense.Zeros(e.rows, e.columns)
+	g := r.General()
+
+	for row := 0; row < e.rows; row++ {
+		e.evaluate(row, g.Data[row*g.Stride:row*g.Stride+e.columns])
+	}
+
+	return r
+}
+
+// Create an expression of the receiver plus "sign" times "f".
+func (e *Expression) combine(f *Expression, sign float64) *Expression {
+	validates.ShapeShouldBeSame(e, f)
+
+	buffer := make([]float64, e.columns)
+
+	evaluate := func(row int, elements []float64) {
+		e.evaluate(row, elements)
+		f.evaluate(row, buffer)
+
+		kernels.Axpy(sign, buffer, elements)
+	}
+
+	return &Expression{rows: e.rows, columns: e.columns, evaluate: evaluate}
+}
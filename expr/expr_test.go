@@ -0,0 +1,83 @@
+package expr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestAddScaleMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	)
+
+	n := dense.New(2, 3)(
+		5, 4, 3,
+		2, 1, 0,
+	)
+
+	r := dense.New(2, 3)(
+		10, 10, 10,
+		10, 10, 10,
+	)
+
+	if !Add(m, n).Scale(2).Eval().Equal(r) {
+		t.Fatal("The expression should be evaluated as (m + n) * 2.")
+	}
+
+	if !m.Equal(dense.New(2, 3)(0, 1, 2, 3, 4, 5)) {
+		t.Fatal("The operands should not be rewritten.")
+	}
+}
+
+func TestSubtractApplyMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		4, 9,
+		16, 25,
+	).Transpose()
+
+	n := dense.New(2, 2)(
+		4, 0,
+		0, 0,
+	)
+
+	r := dense.New(2, 2)(
+		0, 4,
+		3, 5,
+	)
+
+	if Subtract(m, n).Apply(math.Sqrt).Eval().Equal(r) {
+		return
+	}
+
+	t.Fatal("The expression should be evaluated as sqrt(m^T - n) for transposed operands.")
+}
+
+func TestEvalReadsOperandsLazilyMutableDense(t *testing.T) {
+	m := dense.Zeros(2, 2).View(0, 0, 1, 2).(*dense.Matrix)
+
+	e := Of(m).Add(Of(m).Scale(3))
+
+	m.Update(0, 1, 1)
+
+	if e.Eval().Equal(dense.New(1, 2)(0, 4)) {
+		return
+	}
+
+	t.Fatal("The operands should be read when the expression is evaluated.")
+}
+
+func TestAddCausesPanicForDifferentShape(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatal("The operands of different shapes should cause a panic.")
+	}()
+
+	Add(dense.Zeros(2, 2), dense.Zeros(2, 3))
+}
@@ -1,5 +1,9 @@
 package matrix
 
+import (
+	"math"
+)
+
 // Check whether "m" is zero matrix or not.
 func IsZeros(m Matrix) bool {
 	return !m.NonZeros().HasNext()
@@ -32,6 +36,20 @@ func IsIdentity(m Matrix) bool {
 	return isSpecialDiagonal(m, match)
 }
 
+// Check whether "m" is identity matrix or not,
+// allowing every element to differ by "epsilon" at most.
+func IsIdentityApprox(m Matrix, epsilon float64) bool {
+	match := func(element float64, row, column int) bool {
+		if row == column {
+			return math.Abs(element-1) <= epsilon
+		} else {
+			return math.Abs(element) <= epsilon
+		}
+	}
+
+	return isSpecialDiagonal(m, match)
+}
+
 // Check whether "m" is scalar matrix or not.
 func IsScalar(m Matrix) bool {
 	scalar := m.Get(0, 0)
@@ -47,6 +65,82 @@ func IsScalar(m Matrix) bool {
 	return isSpecialDiagonal(m, match)
 }
 
+// Check whether "m" is upper triangular matrix or not.
+func IsUpperTriangular(m Matrix) bool {
+	match := func(element float64, row, column int) bool {
+		return row <= column || element == 0
+	}
+
+	return isSpecialDiagonal(m, match)
+}
+
+// Check whether "m" is lower triangular matrix or not.
+func IsLowerTriangular(m Matrix) bool {
+	match := func(element float64, row, column int) bool {
+		return row >= column || element == 0
+	}
+
+	return isSpecialDiagonal(m, match)
+}
+
+// Check whether "m" is orthogonal matrix or not,
+// allowing every element of the product of the transpose and "m" to differ from identity by "epsilon" at most.
+func IsOrthogonal(m Matrix, epsilon float64) bool {
+	if !IsSquare(m) {
+		return false
+	}
+
+	return IsIdentityApprox(m.Transpose().Multiply(m), epsilon)
+}
+
+// Check whether "m" is symmetric matrix or not.
+func IsSymmetric(m Matrix) bool {
+	return IsSymmetricApprox(m, 0)
+}
+
+// Check whether "m" is symmetric matrix or not,
+// allowing the elements at transposed positions to differ by "epsilon" at most.
+func IsSymmetricApprox(m Matrix, epsilon float64) bool {
+	match := func(element, transposed float64) bool {
+		return math.Abs(element-transposed) <= epsilon
+	}
+
+	return isTransposedPair(m, match)
+}
+
+// Check whether "m" is skew-symmetric matrix or not.
+func IsSkewSymmetric(m Matrix) bool {
+	return IsSkewSymmetricApprox(m, 0)
+}
+
+// Check whether "m" is skew-symmetric matrix or not,
+// allowing the elements at transposed positions to differ from the negations by "epsilon" at most.
+func IsSkewSymmetricApprox(m Matrix, epsilon float64) bool {
+	match := func(element, transposed float64) bool {
+		return math.Abs(element+transposed) <= epsilon
+	}
+
+	return isTransposedPair(m, match)
+}
+
+// Check whether "m" is square matrix and every pair of the elements at transposed positions satisfies "match".
+// The diagonal elements are paired with themselves.
+func isTransposedPair(m Matrix, match func(element, transposed float64) bool) bool {
+	if !IsSquare(m) {
+		return false
+	}
+
+	for row := 0; row < m.Rows(); row++ {
+		for column := row; column < m.Columns(); column++ {
+			if !match(m.Get(row, column), m.Get(column, row)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 /*
 matchFunc is a type of functions to be used check an element satisfies arbitrary condition.
 */
@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"math"
 	"testing"
 
 	"github.com/mitsuse/matrix-go/dense"
@@ -36,6 +37,19 @@ func TestIsNotZerosMutableDense(t *testing.T) {
 	t.Fatal("This matrix should not be zeros.")
 }
 
+func TestIsZerosWithZeroThresholdMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		1e-15, 0,
+		0, -1e-15,
+	).SetZeroThreshold(1e-12)
+
+	if IsZeros(m) {
+		return
+	}
+
+	t.Fatal("This matrix should be zeros within the zero threshold.")
+}
+
 func TestIsSquareMutableDense(t *testing.T) {
 	m := dense.New(4, 4)(
 		0, 1, 2, 3,
@@ -156,6 +170,34 @@ func TestIsNotIdentityNonSquareMutableDense(t *testing.T) {
 	t.Fatal("This matrix should not be identity.")
 }
 
+func TestIsIdentityApproxMutableDense(t *testing.T) {
+	m := dense.New(3, 3)(
+		1.001, 0, -0.001,
+		0, 0.999, 0,
+		0.0005, 0, 1,
+	)
+
+	if IsIdentityApprox(m, 0.01) && !IsIdentityApprox(m, 0.0001) {
+		return
+	}
+
+	t.Fatal("This matrix should be identity only within the tolerance.")
+}
+
+func TestIsNotIdentityApproxNonSquareMutableDense(t *testing.T) {
+	m := dense.New(3, 2)(
+		1, 0,
+		0, 1,
+		0, 0,
+	)
+
+	if !IsIdentityApprox(m, 0.1) {
+		return
+	}
+
+	t.Fatal("This matrix should not be identity.")
+}
+
 func TestIsScalarMutableDense(t *testing.T) {
 	m := dense.New(4, 4)(
 		7, 0, 0, 0,
@@ -200,3 +242,171 @@ func TestIsNotScalarNonSquareMutableDense(t *testing.T) {
 
 	t.Fatal("This matrix should not be scalar.")
 }
+
+func TestIsSymmetricMutableDense(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 3,
+		2, 4, 5,
+		3, 5, 6,
+	)
+
+	if IsSymmetric(m) && IsSymmetric(m.Transpose()) {
+		return
+	}
+
+	t.Fatal("This matrix should be symmetric.")
+}
+
+func TestIsNotSymmetricMutableDense(t *testing.T) {
+	matrices := []Matrix{
+		dense.New(3, 3)(
+			1, 2, 3,
+			2, 4, 5,
+			3, 6, 6,
+		),
+		dense.New(2, 3)(
+			1, 2, 3,
+			2, 4, 5,
+		),
+	}
+
+	for _, m := range matrices {
+		if IsSymmetric(m) {
+			t.Fatal("This matrix should not be symmetric.")
+		}
+	}
+}
+
+func TestIsSymmetricApproxMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 2,
+		2+1e-9, 4,
+	)
+
+	if IsSymmetricApprox(m, 1e-8) && !IsSymmetric(m) && !IsSymmetricApprox(m, 1e-10) {
+		return
+	}
+
+	t.Fatal("This matrix should be symmetric only within the tolerance.")
+}
+
+func TestIsSkewSymmetricMutableDense(t *testing.T) {
+	m := dense.New(3, 3)(
+		0, 2, -3,
+		-2, 0, 5,
+		3, -5, 0,
+	)
+
+	if IsSkewSymmetric(m) && !IsSymmetric(m) {
+		return
+	}
+
+	t.Fatal("This matrix should be skew-symmetric.")
+}
+
+func TestIsNotSkewSymmetricMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 2,
+		-2, 0,
+	)
+
+	if !IsSkewSymmetric(m) && IsSkewSymmetricApprox(m, 2) {
+		return
+	}
+
+	t.Fatal("The diagonal of a skew-symmetric matrix should be zeros.")
+}
+
+func TestIsUpperTriangularMutableDense(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 3,
+		0, 4, 5,
+		0, 0, 6,
+	)
+
+	if IsUpperTriangular(m) && !IsLowerTriangular(m) {
+		return
+	}
+
+	t.Fatal("This matrix should be upper triangular only.")
+}
+
+func TestIsLowerTriangularMutableDense(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, 2, 3,
+		0, 4, 5,
+		0, 0, 6,
+	).Transpose()
+
+	if IsLowerTriangular(m) && !IsUpperTriangular(m) {
+		return
+	}
+
+	t.Fatal("This matrix should be lower triangular only.")
+}
+
+func TestIsTriangularForDiagonalMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 0,
+		0, 2,
+	)
+
+	if IsUpperTriangular(m) && IsLowerTriangular(m) {
+		return
+	}
+
+	t.Fatal("A diagonal matrix should be both upper and lower triangular.")
+}
+
+func TestIsNotTriangularForNonSquareMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		0, 4, 5,
+	)
+
+	if !IsUpperTriangular(m) && !IsLowerTriangular(m) {
+		return
+	}
+
+	t.Fatal("A non-square matrix should not be triangular.")
+}
+
+func TestIsOrthogonalMutableDense(t *testing.T) {
+	c, s := math.Cos(0.3), math.Sin(0.3)
+
+	m := dense.New(3, 3)(
+		c, -s, 0,
+		s, c, 0,
+		0, 0, 1,
+	)
+
+	if IsOrthogonal(m, 1e-12) && IsOrthogonal(m.Transpose(), 1e-12) {
+		return
+	}
+
+	t.Fatal("A rotation matrix should be orthogonal.")
+}
+
+func TestIsNotOrthogonalMutableDense(t *testing.T) {
+	matrices := []Matrix{
+		dense.New(2, 2)(
+			1, 1,
+			0, 1,
+		),
+		dense.New(2, 2)(
+			2, 0,
+			0, 2,
+		),
+		dense.New(3, 2)(
+			1, 0,
+			0, 1,
+			0, 0,
+		),
+	}
+
+	for _, m := range matrices {
+		if IsOrthogonal(m, 1e-12) {
+			t.Fatal("This matrix should not be orthogonal.")
+		}
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Package "kernels" provides the inner loops of dense operations on slices of float64.
+The loops are unrolled by four, and the order of floating-point operations is the same as the simple loops,
+so the results are identical to them.
+*/
+package kernels
+
+// Add "alpha" times "x" to "y" element by element.
+// Only the first len(x) elements of "y" are updated, and "y" should not be shorter than "x".
+func Axpy(alpha float64, x, y []float64) {
+	y = y[:len(x)]
+
+	i := 0
+	for ; i+4 <= len(x); i += 4 {
+		y[i] += alpha * x[i]
+		y[i+1] += alpha * x[i+1]
+		y[i+2] += alpha * x[i+2]
+		y[i+3] += alpha * x[i+3]
+	}
+
+	for ; i < len(x); i++ {
+		y[i] += alpha * x[i]
+	}
+}
+
+// Multiply every element of "x" by "alpha".
+func Scale(alpha float64, x []float64) {
+	i := 0
+	for ; i+4 <= len(x); i += 4 {
+		x[i] *= alpha
+		x[i+1] *= alpha
+		x[i+2] *= alpha
+		x[i+3] *= alpha
+	}
+
+	for ; i < len(x); i++ {
+		x[i] *= alpha
+	}
+}
+
+// Return the sum of the products of the elements of "x" and "y" in order.
+// "y" should not be shorter than "x".
+func Dot(x, y []float64) float64 {
+	y = y[:len(x)]
+
+	sum := 0.0
+
+	i := 0
+	for ; i+4 <= len(x); i += 4 {
+		sum += x[i] * y[i]
+		sum += x[i+1] * y[i+1]
+		sum += x[i+2] * y[i+2]
+		sum += x[i+3] * y[i+3]
+	}
+
+	for ; i < len(x); i++ {
+		sum += x[i] * y[i]
+	}
+
+	return sum
+}
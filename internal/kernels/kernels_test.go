@@ -0,0 +1,67 @@
+package kernels
+
+import (
+	"testing"
+)
+
+func sequence(size int, scale float64) []float64 {
+	x := make([]float64, size)
+	for i := range x {
+		x[i] = float64(i)*scale - 1
+	}
+
+	return x
+}
+
+func TestAxpyAddsScaledElements(t *testing.T) {
+	for size := 0; size <= 9; size++ {
+		x, y := sequence(size, 0.5), sequence(size+2, 1.25)
+
+		expected := append([]float64{}, y...)
+		for i := range x {
+			expected[i] += 3 * x[i]
+		}
+
+		Axpy(3, x, y)
+
+		for i := range y {
+			if y[i] != expected[i] {
+				t.Fatalf("The element %d of %d elements should be %v, but is %v.", i, size, expected[i], y[i])
+			}
+		}
+	}
+}
+
+func TestScaleMultipliesElements(t *testing.T) {
+	for size := 0; size <= 9; size++ {
+		x := sequence(size, 0.5)
+
+		expected := append([]float64{}, x...)
+		for i := range expected {
+			expected[i] *= -1.5
+		}
+
+		Scale(-1.5, x)
+
+		for i := range x {
+			if x[i] != expected[i] {
+				t.Fatalf("The element %d of %d elements should be %v, but is %v.", i, size, expected[i], x[i])
+			}
+		}
+	}
+}
+
+func TestDotSumsProductsInOrder(t *testing.T) {
+	for size := 0; size <= 9; size++ {
+		x, y := sequence(size, 0.1), sequence(size, 0.3)
+
+		expected := 0.0
+		for i := range x {
+			expected += x[i] * y[i]
+		}
+
+		if actual := Dot(x, y); actual != expected {
+			t.Fatalf("The dot product of %d elements should be %v, but is %v.", size, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+/*
+Package "msgpack" implements the subset of MessagePack needed to encode matrices:
+maps, arrays, strings, integers and floating-point numbers.
+*/
+package msgpack
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+const (
+	UnexpectedTypeError = "UnexpectedTypeError"
+	ShortBufferError    = "ShortBufferError"
+)
+
+/*
+"Writer" appends MessagePack values to a byte slice.
+*/
+type Writer struct {
+	b []byte
+}
+
+func NewWriter(capacity int) *Writer {
+	w := &Writer{
+		b: make([]byte, 0, capacity),
+	}
+
+	return w
+}
+
+// Return the bytes written so far.
+func (w *Writer) Bytes() []byte {
+	return w.b
+}
+
+func (w *Writer) WriteMapHeader(size int) {
+	w.writeHeader(size, 0x80, 0xde, 0xdf)
+}
+
+func (w *Writer) WriteArrayHeader(size int) {
+	w.writeHeader(size, 0x90, 0xdc, 0xdd)
+}
+
+func (w *Writer) writeHeader(size int, fix, short, long byte) {
+	switch {
+	case size < 16:
+		w.b = append(w.b, fix|byte(size))
+	case size <= math.MaxUint16:
+		w.b = append(w.b, short, byte(size>>8), byte(size))
+	default:
+		w.b = append(w.b, long)
+		w.b = appendUint32(w.b, uint32(size))
+	}
+}
+
+func (w *Writer) WriteString(s string) {
+	switch {
+	case len(s) < 32:
+		w.b = append(w.b, 0xa0|byte(len(s)))
+	case len(s) <= math.MaxUint8:
+		w.b = append(w.b, 0xd9, byte(len(s)))
+	case len(s) <= math.MaxUint16:
+		w.b = append(w.b, 0xda, byte(len(s)>>8), byte(len(s)))
+	default:
+		w.b = append(w.b, 0xdb)
+		w.b = appendUint32(w.b, uint32(len(s)))
+	}
+
+	w.b = append(w.b, s...)
+}
+
+func (w *Writer) WriteInt(n int64) {
+	if -32 <= n && n < 128 {
+		w.b = append(w.b, byte(n))
+		return
+	}
+
+	w.b = append(w.b, 0xd3)
+	w.b = appendUint64(w.b, uint64(n))
+}
+
+func (w *Writer) WriteFloat64(f float64) {
+	w.b = append(w.b, 0xcb)
+	w.b = appendUint64(w.b, math.Float64bits(f))
+}
+
+func appendUint32(b []byte, n uint32) []byte {
+	var buffer [4]byte
+	binary.BigEndian.PutUint32(buffer[:], n)
+
+	return append(b, buffer[:]...)
+}
+
+func appendUint64(b []byte, n uint64) []byte {
+	var buffer [8]byte
+	binary.BigEndian.PutUint64(buffer[:], n)
+
+	return append(b, buffer[:]...)
+}
+
+/*
+"Reader" reads MessagePack values from a byte slice.
+*/
+type Reader struct {
+	b []byte
+}
+
+func NewReader(b []byte) *Reader {
+	r := &Reader{
+		b: b,
+	}
+
+	return r
+}
+
+// Return the number of unread bytes.
+func (r *Reader) Len() int {
+	return len(r.b)
+}
+
+func (r *Reader) next(size int) ([]byte, error) {
+	if len(r.b) < size {
+		return nil, errors.New(ShortBufferError)
+	}
+
+	b := r.b[:size]
+	r.b = r.b[size:]
+
+	return b, nil
+}
+
+func (r *Reader) ReadMapHeader() (int, error) {
+	return r.readHeader(0x80, 0xde, 0xdf)
+}
+
+func (r *Reader) ReadArrayHeader() (int, error) {
+	return r.readHeader(0x90, 0xdc, 0xdd)
+}
+
+func (r *Reader) readHeader(fix, short, long byte) (int, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case b[0]&0xf0 == fix:
+		return int(b[0] & 0x0f), nil
+	case b[0] == short:
+		return r.readLength(2)
+	case b[0] == long:
+		return r.readLength(4)
+	}
+
+	return 0, errors.New(UnexpectedTypeError)
+}
+
+func (r *Reader) readLength(size int) (int, error) {
+	b, err := r.next(size)
+	if err != nil {
+		return 0, err
+	}
+
+	if size == 1 {
+		return int(b[0]), nil
+	}
+
+	if size == 2 {
+		return int(binary.BigEndian.Uint16(b)), nil
+	}
+
+	return int(binary.BigEndian.Uint32(b)), nil
+}
+
+func (r *Reader) ReadString() (string, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return "", err
+	}
+
+	var length int
+
+	switch {
+	case b[0]&0xe0 == 0xa0:
+		length = int(b[0] & 0x1f)
+	case b[0] == 0xd9:
+		length, err = r.readLength(1)
+	case b[0] == 0xda:
+		length, err = r.readLength(2)
+	case b[0] == 0xdb:
+		length, err = r.readLength(4)
+	default:
+		return "", errors.New(UnexpectedTypeError)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	s, err := r.next(length)
+	if err != nil {
+		return "", err
+	}
+
+	return string(s), nil
+}
+
+func (r *Reader) ReadInt() (int64, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return 0, err
+	}
+
+	switch t := b[0]; {
+	case t < 0x80 || 0xe0 <= t:
+		return int64(int8(t)), nil
+	case 0xcc <= t && t <= 0xcf:
+		v, err := r.next(1 << (t - 0xcc))
+		if err != nil {
+			return 0, err
+		}
+
+		n := uint64(0)
+		for _, x := range v {
+			n = n<<8 | uint64(x)
+		}
+
+		if n > math.MaxInt64 {
+			return 0, errors.New(UnexpectedTypeError)
+		}
+
+		return int64(n), nil
+	case 0xd0 <= t && t <= 0xd3:
+		v, err := r.next(1 << (t - 0xd0))
+		if err != nil {
+			return 0, err
+		}
+
+		n := uint64(0)
+		for _, x := range v {
+			n = n<<8 | uint64(x)
+		}
+
+		// Extend the sign of the value shorter than 64 bits.
+		shift := 64 - 8*uint(len(v))
+
+		return int64(n<<shift) >> shift, nil
+	}
+
+	return 0, errors.New(UnexpectedTypeError)
+}
+
+func (r *Reader) ReadFloat64() (float64, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return 0, err
+	}
+
+	switch b[0] {
+	case 0xca:
+		v, err := r.next(4)
+		if err != nil {
+			return 0, err
+		}
+
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(v))), nil
+	case 0xcb:
+		v, err := r.next(8)
+		if err != nil {
+			return 0, err
+		}
+
+		return math.Float64frombits(binary.BigEndian.Uint64(v)), nil
+	}
+
+	return 0, errors.New(UnexpectedTypeError)
+}
@@ -0,0 +1,92 @@
+package msgpack
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestWriteIntAndReadIntReturnsTheOriginal(t *testing.T) {
+	numbers := []int64{0, 1, 127, 128, -1, -32, -33, math.MaxInt64, math.MinInt64}
+
+	for _, n := range numbers {
+		w := NewWriter(0)
+		w.WriteInt(n)
+
+		m, err := NewReader(w.Bytes()).ReadInt()
+		if err != nil || m != n {
+			t.Fatalf("The integer should be %d, but is %d with %v.", n, m, err)
+		}
+	}
+}
+
+func TestReadIntOfShortTypes(t *testing.T) {
+	tests := map[string]int64{
+		"\xcc\xff":             255,
+		"\xcd\x01\x00":         256,
+		"\xd0\xff":             -1,
+		"\xd1\xff\x00":         -256,
+		"\xd2\x80\x00\x00\x00": math.MinInt32,
+	}
+
+	for b, n := range tests {
+		m, err := NewReader([]byte(b)).ReadInt()
+		if err != nil || m != n {
+			t.Fatalf("The integer of %q should be %d, but is %d with %v.", b, n, m, err)
+		}
+	}
+}
+
+func TestWriteStringAndReadStringReturnsTheOriginal(t *testing.T) {
+	for _, length := range []int{0, 31, 32, 255, 256, 70000} {
+		s := strings.Repeat("a", length)
+
+		w := NewWriter(0)
+		w.WriteString(s)
+
+		r, err := NewReader(w.Bytes()).ReadString()
+		if err != nil || r != s {
+			t.Fatalf("The string of length %d should be read back, but %v occurred.", length, err)
+		}
+	}
+}
+
+func TestWriteHeadersAndReadHeadersReturnsTheOriginal(t *testing.T) {
+	for _, size := range []int{0, 15, 16, 65535, 65536} {
+		w := NewWriter(0)
+		w.WriteMapHeader(size)
+		w.WriteArrayHeader(size)
+
+		r := NewReader(w.Bytes())
+
+		if n, err := r.ReadMapHeader(); err != nil || n != size {
+			t.Fatalf("The size of map should be %d, but is %d with %v.", size, n, err)
+		}
+
+		if n, err := r.ReadArrayHeader(); err != nil || n != size {
+			t.Fatalf("The size of array should be %d, but is %d with %v.", size, n, err)
+		}
+	}
+}
+
+func TestReadFloat64OfFloat32(t *testing.T) {
+	f, err := NewReader([]byte("\xca\x3f\xc0\x00\x00")).ReadFloat64()
+
+	if err == nil && f == 1.5 {
+		return
+	}
+
+	t.Fatalf("The float should be 1.5, but is %v with %v.", f, err)
+}
+
+func TestReadFailsForUnexpectedType(t *testing.T) {
+	r := NewReader([]byte("\xc0"))
+
+	if _, err := r.ReadFloat64(); err == nil || err.Error() != UnexpectedTypeError {
+		t.Fatalf("The nil should cause %s.", UnexpectedTypeError)
+	}
+
+	if _, err := NewReader([]byte("\xcb\x00")).ReadFloat64(); err == nil || err.Error() != ShortBufferError {
+		t.Fatalf("The short float should cause %s.", ShortBufferError)
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Package "parallel" runs independent iterations across goroutines.
+*/
+package parallel
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Call "f" for every index from 0 to "count" - 1 with up to GOMAXPROCS goroutines,
+// and wait for all of the calls.
+// The indexes are split into contiguous ranges, one per goroutine.
+func For(count int, f func(index int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > count {
+		workers = count
+	}
+
+	if workers <= 1 {
+		for index := 0; index < count; index++ {
+			f(index)
+		}
+
+		return
+	}
+
+	var group sync.WaitGroup
+
+	for worker := 0; worker < workers; worker++ {
+		group.Add(1)
+
+		go func(begin, end int) {
+			defer group.Done()
+
+			for index := begin; index < end; index++ {
+				f(index)
+			}
+		}(worker*count/workers, (worker+1)*count/workers)
+	}
+
+	group.Wait()
+}
@@ -0,0 +1,22 @@
+package parallel
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestForCallsEveryIndexOnce(t *testing.T) {
+	for _, count := range []int{0, 1, 7, 100} {
+		calls := make([]int32, count)
+
+		For(count, func(index int) {
+			atomic.AddInt32(&calls[index], 1)
+		})
+
+		for index, call := range calls {
+			if call != 1 {
+				t.Fatalf("The index %d of %d should be called once, but is called %d times.", index, count, call)
+			}
+		}
+	}
+}
@@ -1,4 +0,0 @@
-/*
-Package "types" provides types which represent matrix and iterator of elements.
-*/
-package types
@@ -4,9 +4,9 @@ package validates
 
 import "fmt"
 
-const _Panic_name = "NON_POSITIVE_SIZE_PANICDIFFERENT_SIZE_PANICNOT_MULTIPLIABLE_PANICOUT_OF_RANGE_PANICINVALID_ELEMENTS_PANIC"
+const _Panic_name = "NON_POSITIVE_SIZE_PANICDIFFERENT_SIZE_PANICNOT_MULTIPLIABLE_PANICOUT_OF_RANGE_PANICINVALID_ELEMENTS_PANICINVALID_VIEW_PANICNOT_SQUARE_PANICSIZE_OVERFLOW_PANICINVALID_THRESHOLD_PANICNEGATIVE_BANDWIDTH_PANIC"
 
-var _Panic_index = [...]uint8{0, 23, 43, 65, 83, 105}
+var _Panic_index = [...]uint8{0, 23, 43, 65, 83, 105, 123, 139, 158, 181, 205}
 
 func (i Panic) String() string {
 	if i < 0 || i+1 >= Panic(len(_Panic_index)) {
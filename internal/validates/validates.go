@@ -1,7 +1,9 @@
 package validates
 
 import (
-	"github.com/mitsuse/matrix-go/internal/types"
+	"math"
+
+	"github.com/mitsuse/matrix-go/shape"
 )
 
 const (
@@ -11,6 +13,17 @@ const (
 	OUT_OF_RANGE_PANIC
 	INVALID_ELEMENTS_PANIC
 	INVALID_VIEW_PANIC
+	NOT_SQUARE_PANIC
+	SIZE_OVERFLOW_PANIC
+	INVALID_THRESHOLD_PANIC
+	NEGATIVE_BANDWIDTH_PANIC
+)
+
+const (
+	// The size in bytes of float64 elements.
+	ElementSize = 8
+
+	maxInt = int(^uint(0) >> 1)
 )
 
 //go:generate stringer -type=Panic
@@ -24,6 +37,34 @@ func ShapeShouldBePositive(row, column int) {
 	panic(NON_POSITIVE_SIZE_PANIC)
 }
 
+// Compute the number of elements of a "rows x columns" matrix and their size in bytes.
+// "ok" is false when either of them overflows int or the arguments are not positive.
+func CheckedSize(rows, columns, elementSize int) (elements, bytes int, ok bool) {
+	if rows <= 0 || columns <= 0 || elementSize <= 0 {
+		return 0, 0, false
+	}
+
+	if rows > maxInt/columns {
+		return 0, 0, false
+	}
+	elements = rows * columns
+
+	if elements > maxInt/elementSize {
+		return 0, 0, false
+	}
+	bytes = elements * elementSize
+
+	return elements, bytes, true
+}
+
+func ShapeShouldNotOverflow(rows, columns int) {
+	if _, _, ok := CheckedSize(rows, columns, ElementSize); ok {
+		return
+	}
+
+	panic(SIZE_OVERFLOW_PANIC)
+}
+
 type HasShape interface {
 	Shape() (rows, columns int)
 	Rows() int
@@ -41,6 +82,14 @@ func ShapeShouldBeSame(m, n HasShape) {
 	panic(DIFFERENT_SIZE_PANIC)
 }
 
+func ShapeShouldBeSquare(m HasShape) {
+	if m.Rows() == m.Columns() {
+		return
+	}
+
+	panic(NOT_SQUARE_PANIC)
+}
+
 func ShapeShouldBeMultipliable(m, n HasShape) {
 	if m.Columns() == n.Rows() {
 		return
@@ -57,7 +106,7 @@ func IndexShouldBeInRange(rows, columns, row, column int) {
 	panic(OUT_OF_RANGE_PANIC)
 }
 
-func ViewShouldBeInBase(base, view *types.Shape, offset *types.Index) {
+func ViewShouldBeInBase(base, view *shape.Shape, offset *shape.Index) {
 	rows := offset.Row() + view.Rows()
 	columns := offset.Column() + view.Columns()
 
@@ -67,3 +116,19 @@ func ViewShouldBeInBase(base, view *types.Shape, offset *types.Index) {
 
 	panic(INVALID_VIEW_PANIC)
 }
+
+func ThresholdShouldBeValid(epsilon float64) {
+	if epsilon >= 0 && !math.IsNaN(epsilon) {
+		return
+	}
+
+	panic(INVALID_THRESHOLD_PANIC)
+}
+
+func BandwidthShouldNotBeNegative(lower, upper int) {
+	if lower >= 0 && upper >= 0 {
+		return
+	}
+
+	panic(NEGATIVE_BANDWIDTH_PANIC)
+}
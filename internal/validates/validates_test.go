@@ -3,7 +3,7 @@ package validates
 import (
 	"testing"
 
-	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/shape"
 )
 
 type shapeTest struct {
@@ -122,6 +122,43 @@ func TestShapeShouldBePositiveCausePanicWithNegative(t *testing.T) {
 	ShapeShouldBePositive(test.Rows(), test.Columns())
 }
 
+func TestCheckedSizeComputesElementsAndBytes(t *testing.T) {
+	elements, bytes, ok := CheckedSize(3, 4, ElementSize)
+
+	if ok && elements == 12 && bytes == 96 {
+		return
+	}
+
+	t.Fatalf("The size should be (12, 96, true), but is (%d, %d, %v).", elements, bytes, ok)
+}
+
+func TestCheckedSizeFailsForOverflowingElements(t *testing.T) {
+	if _, _, ok := CheckedSize(maxInt/2, 3, 1); !ok {
+		return
+	}
+
+	t.Fatal("The number of elements overflowing int should be detected.")
+}
+
+func TestCheckedSizeFailsForOverflowingBytes(t *testing.T) {
+	if _, _, ok := CheckedSize(maxInt/16, 4, ElementSize); !ok {
+		return
+	}
+
+	t.Fatal("The size in bytes overflowing int should be detected.")
+}
+
+func TestShapeShouldNotOverflowCausesPanic(t *testing.T) {
+	defer func() {
+		if p := recover(); p == SIZE_OVERFLOW_PANIC {
+			return
+		}
+
+		t.Fatalf("The overflowing shape should cause %s.", SIZE_OVERFLOW_PANIC)
+	}()
+	ShapeShouldNotOverflow(maxInt, maxInt)
+}
+
 func TestShapeShouldBeSameCauseNathing(t *testing.T) {
 	m := &shapeTest{rows: 4, columns: 3}
 	n := &shapeTest{rows: 4, columns: 3}
@@ -202,6 +239,30 @@ func TestShapeShouldBeMultipliableCausesPanic(t *testing.T) {
 	ShapeShouldBeMultipliable(m, n)
 }
 
+func TestShapeShouldBeSquareCausesNothing(t *testing.T) {
+	test := &shapeTest{rows: 3, columns: 3}
+
+	defer func() {
+		if p := recover(); p != nil {
+			t.Fatalf("Square shape should be valid, but causes %s.", p)
+		}
+	}()
+	ShapeShouldBeSquare(test)
+}
+
+func TestShapeShouldBeSquareCausesPanic(t *testing.T) {
+	test := &shapeTest{rows: 3, columns: 2}
+
+	defer func() {
+		if p := recover(); p == NOT_SQUARE_PANIC {
+			return
+		}
+
+		t.Fatalf("Non-square shape should cause %s.", NOT_SQUARE_PANIC)
+	}()
+	ShapeShouldBeSquare(test)
+}
+
 func TestIndexShouldBeInRangeCausesNothing(t *testing.T) {
 	testSeq := []*rangeTest{
 		&rangeTest{
@@ -389,9 +450,9 @@ func TestIndexShouldBeInRangeCausesPanicWithLarge(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForNegativeRowOffset(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(1, 1)
-	offset := types.NewIndex(-1, 0)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(1, 1)
+	offset := shape.NewIndex(-1, 0)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -402,9 +463,9 @@ func TestViewShouldBeInBasePanicsForNegativeRowOffset(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForNegativeColumnOffset(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(1, 1)
-	offset := types.NewIndex(0, -1)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(1, 1)
+	offset := shape.NewIndex(0, -1)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -415,9 +476,9 @@ func TestViewShouldBeInBasePanicsForNegativeColumnOffset(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForTooLargeRowOffset(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(1, 1)
-	offset := types.NewIndex(4, 0)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(1, 1)
+	offset := shape.NewIndex(4, 0)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -428,9 +489,9 @@ func TestViewShouldBeInBasePanicsForTooLargeRowOffset(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForTooLargeColumnOffset(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(1, 1)
-	offset := types.NewIndex(0, 4)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(1, 1)
+	offset := shape.NewIndex(0, 4)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -441,9 +502,9 @@ func TestViewShouldBeInBasePanicsForTooLargeColumnOffset(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForTooNonPositiveRows(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(0, 1)
-	offset := types.NewIndex(0, 0)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(0, 1)
+	offset := shape.NewIndex(0, 0)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -454,9 +515,9 @@ func TestViewShouldBeInBasePanicsForTooNonPositiveRows(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForNonPositiveColumns(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(1, 0)
-	offset := types.NewIndex(0, 0)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(1, 0)
+	offset := shape.NewIndex(0, 0)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -467,9 +528,9 @@ func TestViewShouldBeInBasePanicsForNonPositiveColumns(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForTooLargeRows(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(5, 1)
-	offset := types.NewIndex(0, 0)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(5, 1)
+	offset := shape.NewIndex(0, 0)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -480,9 +541,9 @@ func TestViewShouldBeInBasePanicsForTooLargeRows(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForTooLargeColumns(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(1, 5)
-	offset := types.NewIndex(0, 0)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(1, 5)
+	offset := shape.NewIndex(0, 0)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -493,9 +554,9 @@ func TestViewShouldBeInBasePanicsForTooLargeColumns(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForRowsExtendedOutside(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(2, 1)
-	offset := types.NewIndex(3, 0)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(2, 1)
+	offset := shape.NewIndex(3, 0)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -506,9 +567,9 @@ func TestViewShouldBeInBasePanicsForRowsExtendedOutside(t *testing.T) {
 }
 
 func TestViewShouldBeInBasePanicsForColumnsExtendedOutside(t *testing.T) {
-	base := types.NewShape(4, 4)
-	view := types.NewShape(1, 2)
-	offset := types.NewIndex(0, 3)
+	base := shape.NewShape(4, 4)
+	view := shape.NewShape(1, 2)
+	offset := shape.NewIndex(0, 3)
 
 	defer func() {
 		if p := recover(); p != INVALID_VIEW_PANIC {
@@ -517,3 +578,35 @@ func TestViewShouldBeInBasePanicsForColumnsExtendedOutside(t *testing.T) {
 	}()
 	ViewShouldBeInBase(base, view, offset)
 }
+
+func TestThresholdShouldBeValidCausesNothing(t *testing.T) {
+	defer func() {
+		if p := recover(); p != nil {
+			t.Fatalf("A non-negative threshold should not cause %s.", p)
+		}
+	}()
+	ThresholdShouldBeValid(0)
+	ThresholdShouldBeValid(0.5)
+}
+
+func TestThresholdShouldBeValidCausesPanic(t *testing.T) {
+	defer func() {
+		if p := recover(); p == INVALID_THRESHOLD_PANIC {
+			return
+		}
+
+		t.Fatalf("A negative threshold should cause %s.", INVALID_THRESHOLD_PANIC)
+	}()
+	ThresholdShouldBeValid(-1)
+}
+
+func TestBandwidthShouldNotBeNegativeCausesPanic(t *testing.T) {
+	defer func() {
+		if p := recover(); p == NEGATIVE_BANDWIDTH_PANIC {
+			return
+		}
+
+		t.Fatalf("A negative bandwidth should cause %s.", NEGATIVE_BANDWIDTH_PANIC)
+	}()
+	BandwidthShouldNotBeNegative(0, -1)
+}
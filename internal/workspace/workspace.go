@@ -0,0 +1,85 @@
+/*
+Package "workspace" provides pooled slices of float64 for temporaries of operations.
+Slices are pooled by the classes of powers of two, so that those of similar sizes are reused.
+Pooling is disabled by default, and "Get" and "Put" behave as "make" and nothing until "Enable" is called.
+*/
+package workspace
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// The number of classes, which covers any length of slices.
+	classes = 64
+)
+
+var (
+	pools [classes]sync.Pool
+
+	// The number of callers which have enabled pooling.
+	enabled int32
+)
+
+// Enable pooling until "Disable" is called as many times as "Enable".
+func Enable() {
+	atomic.AddInt32(&enabled, 1)
+}
+
+// Disable pooling enabled by "Enable".
+func Disable() {
+	atomic.AddInt32(&enabled, -1)
+}
+
+// Check whether pooling is enabled.
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) > 0
+}
+
+// Return a slice of "size" zeros.
+// The slice is taken from the pool if possible, and should be returned with "Put" after use.
+func Get(size int) []float64 {
+	if !Enabled() {
+		return make([]float64, size)
+	}
+
+	class := classOf(size)
+
+	if b, exist := pools[class].Get().([]float64); exist {
+		b = b[:size]
+		for i := range b {
+			b[i] = 0
+		}
+
+		return b
+	}
+
+	return make([]float64, size, 1<<uint(class))
+}
+
+// Return the slice taken with "Get" to the pool.
+// The slice must not be used after that.
+// Slices which are not taken with "Get" are ignored.
+func Put(b []float64) {
+	if !Enabled() || cap(b) == 0 {
+		return
+	}
+
+	class := classOf(cap(b))
+	if 1<<uint(class) != cap(b) {
+		return
+	}
+
+	pools[class].Put(b[:0])
+}
+
+// Return the smallest class whose slices can hold "size" elements.
+func classOf(size int) int {
+	class := 0
+	for 1<<uint(class) < size {
+		class++
+	}
+
+	return class
+}
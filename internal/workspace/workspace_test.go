@@ -0,0 +1,63 @@
+package workspace
+
+import (
+	"testing"
+)
+
+func TestGetReturnsZeros(t *testing.T) {
+	Enable()
+	defer Disable()
+
+	b := Get(5)
+	for i := range b {
+		b[i] = float64(i + 1)
+	}
+	Put(b)
+
+	b = Get(7)
+
+	if len(b) != 7 || cap(b) != 8 {
+		t.Fatalf("The slice should have 7 elements in the class of 8, but has %d in %d.", len(b), cap(b))
+	}
+
+	for i, element := range b {
+		if element != 0 {
+			t.Fatalf("The element %d should be zero, but is %v.", i, element)
+		}
+	}
+}
+
+func TestPutIgnoresSlicesWhenDisabled(t *testing.T) {
+	if Enabled() {
+		t.Fatal("Pooling should be disabled by default.")
+	}
+
+	b := Get(3)
+	if len(b) != 3 || cap(b) != 3 {
+		t.Fatalf("The slice should be made as it is, but has %d elements in %d.", len(b), cap(b))
+	}
+
+	Put(b)
+}
+
+type classTest struct {
+	size  int
+	class int
+}
+
+func TestClassOfReturnsSmallestPowerOfTwo(t *testing.T) {
+	tests := []*classTest{
+		&classTest{size: 0, class: 0},
+		&classTest{size: 1, class: 0},
+		&classTest{size: 2, class: 1},
+		&classTest{size: 3, class: 2},
+		&classTest{size: 1024, class: 10},
+		&classTest{size: 1025, class: 11},
+	}
+
+	for _, test := range tests {
+		if class := classOf(test.size); class != test.class {
+			t.Fatalf("The class of %d should be %d, but is %d.", test.size, test.class, class)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package matrix
+
+import (
+	"errors"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+const (
+	SingularError = dense.SingularError
+)
+
+// Create the inverse of "m".
+// Matrices of size 2, 3 and 4 are inverted in the closed form,
+// and the others by "dense.Solve" with identity, which uses Gaussian elimination with partial pivoting by default.
+// When "m" is singular, SingularError is returned.
+// When "m" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func Inverse(m Matrix) (Matrix, error) {
+	validates.ShapeShouldBeSquare(m)
+
+	if s, ok := toSmall(m); ok {
+		b, d := s.adjugate()
+		if d == 0 {
+			return nil, errors.New(SingularError)
+		}
+
+		r := dense.Zeros(s.size, s.size)
+
+		for row := 0; row < s.size; row++ {
+			for column := 0; column < s.size; column++ {
+				r.Update(row, column, b[row][column]/d)
+			}
+		}
+
+		return r, nil
+	}
+
+	r, err := dense.Solve(m, scaledIdentity(m.Rows(), 1))
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
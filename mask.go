@@ -0,0 +1,99 @@
+package matrix
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+/*
+"Comparison" is the kind of operators used to compare elements.
+*/
+type Comparison int
+
+const (
+	CompareGreater Comparison = iota
+	CompareGreaterEqual
+	CompareEqual
+	CompareNotEqual
+	CompareLess
+	CompareLessEqual
+)
+
+// Apply the operator to "x" and "y".
+func (c Comparison) apply(x, y float64) bool {
+	switch c {
+	case CompareGreater:
+		return x > y
+	case CompareGreaterEqual:
+		return x >= y
+	case CompareEqual:
+		return x == y
+	case CompareNotEqual:
+		return x != y
+	case CompareLess:
+		return x < y
+	case CompareLessEqual:
+		return x <= y
+	}
+
+	return false
+}
+
+// Create a new mask matrix, the element of which is 1
+// if the elements of "a" and "b" at the same index satisfy "op", and 0 otherwise.
+// When the shape of "a" and "b" is different,
+// validates.DIFFERENT_SIZE_PANIC will be caused.
+func Compare(a, b Matrix, op Comparison) Matrix {
+	validates.ShapeShouldBeSame(a, b)
+
+	r := dense.Zeros(a.Rows(), a.Columns())
+
+	cursor := a.All()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		if op.apply(element, b.Get(row, column)) {
+			r.Update(row, column, 1)
+		}
+	}
+
+	return r
+}
+
+// Create a new mask matrix, the element of which is 1
+// if the element of "a" and "s" satisfy "op", and 0 otherwise.
+func CompareScalar(a Matrix, s float64, op Comparison) Matrix {
+	r := dense.Zeros(a.Rows(), a.Columns())
+
+	cursor := a.All()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		if op.apply(element, s) {
+			r.Update(row, column, 1)
+		}
+	}
+
+	return r
+}
+
+// Create a new matrix, the element of which is taken from "a" where "mask" is non-zero,
+// and from "b" otherwise.
+// When the shape of "mask", "a" and "b" is different,
+// validates.DIFFERENT_SIZE_PANIC will be caused.
+func Where(mask, a, b Matrix) Matrix {
+	validates.ShapeShouldBeSame(mask, a)
+	validates.ShapeShouldBeSame(mask, b)
+
+	r := dense.Zeros(b.Rows(), b.Columns())
+	r.Add(b)
+
+	cursor := mask.NonZeros()
+
+	for cursor.HasNext() {
+		_, row, column := cursor.Get()
+		r.Update(row, column, a.Get(row, column))
+	}
+
+	return r
+}
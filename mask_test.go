@@ -0,0 +1,102 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+type compareTest struct {
+	op     Comparison
+	result Matrix
+}
+
+func TestCompareMutableDense(t *testing.T) {
+	a := dense.New(1, 3)(1, 2, 3)
+	b := dense.New(1, 3)(3, 2, 1)
+
+	tests := []*compareTest{
+		&compareTest{op: CompareGreater, result: dense.New(1, 3)(0, 0, 1)},
+		&compareTest{op: CompareGreaterEqual, result: dense.New(1, 3)(0, 1, 1)},
+		&compareTest{op: CompareEqual, result: dense.New(1, 3)(0, 1, 0)},
+		&compareTest{op: CompareNotEqual, result: dense.New(1, 3)(1, 0, 1)},
+		&compareTest{op: CompareLess, result: dense.New(1, 3)(1, 0, 0)},
+		&compareTest{op: CompareLessEqual, result: dense.New(1, 3)(1, 1, 0)},
+	}
+
+	for _, test := range tests {
+		if !Compare(a, b, test.op).Equal(test.result) {
+			t.Fatalf("The mask for the operator %d is wrong.", test.op)
+		}
+	}
+}
+
+func TestCompareCausesPanicForDifferentShapeMutableDense(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.DIFFERENT_SIZE_PANIC {
+			return
+		}
+
+		t.Fatalf("Comparing matrices which have different shape should cause %s.", validates.DIFFERENT_SIZE_PANIC)
+	}()
+	Compare(dense.Zeros(1, 2), dense.Zeros(2, 1), CompareEqual)
+}
+
+func TestCompareScalarMutableDense(t *testing.T) {
+	a := dense.New(2, 2)(
+		-1, 0,
+		2, 0.5,
+	)
+
+	r := dense.New(2, 2)(
+		0, 0,
+		1, 1,
+	)
+
+	if CompareScalar(a, 0, CompareGreater).Equal(r) {
+		return
+	}
+
+	t.Fatal("The mask should be 1 for elements greater than the scalar.")
+}
+
+func TestWhereMutableDense(t *testing.T) {
+	mask := dense.New(2, 2)(
+		1, 0,
+		0, 1,
+	)
+
+	a := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	b := dense.New(2, 2)(
+		5, 6,
+		7, 8,
+	)
+
+	r := dense.New(2, 2)(
+		1, 6,
+		7, 4,
+	)
+
+	if Where(mask, a, b).Equal(r) {
+		return
+	}
+
+	t.Fatal("Where should select elements of a where the mask is non-zero, and ones of b otherwise.")
+}
+
+func TestWhereWithComparisonMutableDense(t *testing.T) {
+	a := dense.New(1, 4)(-2, 3, -1, 4)
+
+	r := dense.New(1, 4)(0, 3, 0, 4)
+
+	if Where(CompareScalar(a, 0, CompareGreater), a, dense.Zeros(1, 4)).Equal(r) {
+		return
+	}
+
+	t.Fatal("Where should work with a mask created by comparison.")
+}
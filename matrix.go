@@ -4,16 +4,16 @@ Package "matrix" is an experimental library for matrix manipulation implemented
 package matrix
 
 import (
-	"github.com/mitsuse/matrix-go/internal/types"
+	"github.com/mitsuse/matrix-go/types"
 )
 
 /*
 "Matrix" is the interface for implementations of various matrix types.
+It is the same type as "types.Matrix", which is implemented by dense, banded and other packages,
+and can also be implemented outside of this module.
 For more details, refer "types.Matrix".
 */
-type Matrix interface {
-	types.Matrix
-}
+type Matrix = types.Matrix
 
 /*
 "Cursor" is the interface for iterator for elements of matrix.
@@ -21,6 +21,21 @@ Some implementations of "Cursor" iterate all elements,
 and others iterates elements satisfying conditions.
 For more details, refer "types.Cursor".
 */
-type Cursor interface {
-	types.Cursor
-}
+type Cursor = types.Cursor
+
+/*
+"Capability" is the set of flags declaring what an implementation of "Matrix" supports.
+Check them with "(Matrix).Capabilities().Has".
+For more details, refer "types.Capability".
+*/
+type Capability = types.Capability
+
+// The flags of "Capability".
+const (
+	Mutable    = types.Mutable
+	Sparse     = types.Sparse
+	Contiguous = types.Contiguous
+	ThreadSafe = types.ThreadSafe
+	Views      = types.Views
+	Transposed = types.Transposed
+)
@@ -1 +1,42 @@
 package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+func TestMatrixIsTheSameTypeAsTypesMatrix(t *testing.T) {
+	// Function types are assignable only when "Matrix" and "types.Matrix" are identical.
+	var transpose func(types.Matrix) types.Matrix = func(m Matrix) Matrix {
+		return m.Transpose()
+	}
+
+	var cursor func(types.Matrix) types.Cursor = func(m Matrix) Cursor {
+		return m.All()
+	}
+
+	m := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	if transpose(m).Equal(dense.New(2, 2)(1, 3, 2, 4)) && cursor(m).HasNext() {
+		return
+	}
+
+	t.Fatal("The transpose through \"Matrix\" is wrong.")
+}
+
+func TestCapabilityIsTheSameTypeAsTypesCapability(t *testing.T) {
+	var has func(types.Capability) bool = func(c Capability) bool {
+		return c.Has(Mutable | Contiguous)
+	}
+
+	if has(dense.Zeros(2, 2).Capabilities()) {
+		return
+	}
+
+	t.Fatal("The capabilities through \"Capability\" should have \"Mutable\" and \"Contiguous\".")
+}
@@ -0,0 +1,121 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+/*
+"Norm" is the kind of vector norms.
+*/
+type Norm int
+
+const (
+	L1 Norm = iota
+	L2
+	LInf
+)
+
+/*
+"Axis" specifies whether a reduction is applied to each row or each column.
+*/
+type Axis int
+
+const (
+	RowAxis Axis = iota
+	ColumnAxis
+)
+
+// Scale each row of "m" to have the unit norm of the given kind.
+// Rows whose elements are all zero are left as they are.
+// The norm is computed on every element including those within the zero threshold, all of which are scaled.
+// When "m" is mutable, the elements of "m" are rewritten and "m" is returned.
+func NormalizeRows(m Matrix, norm Norm) Matrix {
+	for row := 0; row < m.Rows(); row++ {
+		v := m.Row(row)
+
+		n := vectorNorm(v, norm)
+		if n == 0 {
+			continue
+		}
+
+		for column := 0; column < m.Columns(); column++ {
+			m.Update(row, column, v.Get(0, column)/n)
+		}
+	}
+
+	return m
+}
+
+// Compute the norms of the given kind for every row or column of "m" in a single pass.
+// For RowAxis, a "rows x 1" column vector is returned,
+// and for ColumnAxis, a "1 x columns" row vector is returned.
+func NormAxis(m Matrix, axis Axis, norm Norm) Matrix {
+	var r Matrix
+	if axis == RowAxis {
+		r = dense.Zeros(m.Rows(), 1)
+	} else {
+		r = dense.Zeros(1, m.Columns())
+	}
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+
+		if axis == RowAxis {
+			column = 0
+		} else {
+			row = 0
+		}
+
+		r.Update(row, column, accumulateNorm(r.Get(row, column), element, norm))
+	}
+
+	elements := r.All()
+
+	for elements.HasNext() {
+		n, row, column := elements.Get()
+		r.Update(row, column, finishNorm(n, norm))
+	}
+
+	return r
+}
+
+// Compute the norm of the given kind over all elements of "m", not skipping those within the zero threshold.
+func vectorNorm(m Matrix, norm Norm) float64 {
+	var n float64
+
+	cursor := m.All()
+
+	for cursor.HasNext() {
+		element, _, _ := cursor.Get()
+		n = accumulateNorm(n, element, norm)
+	}
+
+	return finishNorm(n, norm)
+}
+
+// Accumulate "element" into the partial norm "n".
+func accumulateNorm(n, element float64, norm Norm) float64 {
+	switch norm {
+	case L1:
+		return n + math.Abs(element)
+	case L2:
+		return n + element*element
+	case LInf:
+		return math.Max(n, math.Abs(element))
+	}
+
+	return n
+}
+
+// Convert the partial norm "n" into the norm.
+func finishNorm(n float64, norm Norm) float64 {
+	if norm == L2 {
+		return math.Sqrt(n)
+	}
+
+	return n
+}
@@ -0,0 +1,117 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestNormalizeRowsWithL1MutableDense(t *testing.T) {
+	m := dense.New(3, 3)(
+		1, -2, 1,
+		0, 0, 0,
+		0, 3, 1,
+	)
+
+	r := dense.New(3, 3)(
+		0.25, -0.5, 0.25,
+		0, 0, 0,
+		0, 0.75, 0.25,
+	)
+
+	if NormalizeRows(m, L1).Equal(r) {
+		return
+	}
+
+	t.Fatal("Each row should be scaled to have the unit L1 norm.")
+}
+
+func TestNormalizeRowsWithL2MutableDense(t *testing.T) {
+	m := dense.New(3, 2)(
+		3, -4,
+		0, 0,
+		0, 2,
+	)
+
+	r := dense.New(3, 2)(
+		0.6, -0.8,
+		0, 0,
+		0, 1,
+	)
+
+	if NormalizeRows(m, L2).Equal(r) {
+		return
+	}
+
+	t.Fatal("Each row should be scaled to have the unit L2 norm.")
+}
+
+func TestNormalizeRowsOfTransposeMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 0,
+		3, 2,
+	).Transpose()
+
+	r := dense.New(2, 2)(
+		0.25, 0.75,
+		0, 1,
+	)
+
+	if NormalizeRows(m, L1).Equal(r) {
+		return
+	}
+
+	t.Fatal("NormalizeRows should respect the transposed indexes.")
+}
+
+type normAxisTest struct {
+	axis   Axis
+	norm   Norm
+	result Matrix
+}
+
+func TestNormAxisMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		3, 0, -1,
+		-4, 0, 2,
+	)
+
+	tests := []*normAxisTest{
+		&normAxisTest{axis: RowAxis, norm: L1, result: dense.New(2, 1)(4, 6)},
+		&normAxisTest{axis: RowAxis, norm: LInf, result: dense.New(2, 1)(3, 4)},
+		&normAxisTest{axis: ColumnAxis, norm: L1, result: dense.New(1, 3)(7, 0, 3)},
+		&normAxisTest{axis: ColumnAxis, norm: L2, result: dense.New(1, 3)(5, 0, 2.23606797749979)},
+		&normAxisTest{axis: ColumnAxis, norm: LInf, result: dense.New(1, 3)(4, 0, 2)},
+	}
+
+	for _, test := range tests {
+		if !NormAxis(m, test.axis, test.norm).EqualApprox(test.result, 1e-12) {
+			t.Fatalf("The norms (axis = %d, norm = %d) are wrong.", test.axis, test.norm)
+		}
+	}
+}
+
+func TestNormalizeRowsWithLInfMutableDense(t *testing.T) {
+	m := dense.New(1, 3)(2, -4, 1)
+
+	r := dense.New(1, 3)(0.5, -1, 0.25)
+
+	if NormalizeRows(m, LInf).Equal(r) {
+		return
+	}
+
+	t.Fatal("Each row should be scaled to have the unit infinity norm.")
+}
+
+func TestNormalizeRowsScalesElementsWithinZeroThreshold(t *testing.T) {
+	m := dense.New(1, 3)(0.5, 4, 0.5)
+	m.SetZeroThreshold(1)
+
+	r := dense.New(1, 3)(0.1, 0.8, 0.1)
+
+	if NormalizeRows(m, L1).EqualApprox(r, 1e-12) {
+		return
+	}
+
+	t.Fatal("The elements within the zero threshold should also be scaled.")
+}
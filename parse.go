@@ -0,0 +1,29 @@
+package matrix
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+const (
+	EmptyLiteralError   = dense.EmptyLiteralError
+	RaggedLiteralError  = dense.RaggedLiteralError
+	InvalidLiteralError = dense.InvalidLiteralError
+)
+
+// Create a new matrix from the literal such as "1 2 3; 4 5 6".
+// This is the same as "dense.Parse" except that the result is typed as "Matrix".
+func Parse(literal string) (Matrix, error) {
+	m, err := dense.Parse(literal)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Create a new matrix from the literal as "Parse" does.
+// When the literal is invalid, the error returned by "Parse" is caused as panic.
+// This is intended for tests and examples with literals known to be valid.
+func MustParse(literal string) Matrix {
+	return dense.MustParse(literal)
+}
@@ -0,0 +1,92 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+type parseTest struct {
+	literal string
+	matrix  Matrix
+}
+
+func TestParseMutableDense(t *testing.T) {
+	tests := []*parseTest{
+		&parseTest{
+			literal: "1 2 3; 4 5 6",
+			matrix: dense.New(2, 3)(
+				1, 2, 3,
+				4, 5, 6,
+			),
+		},
+		&parseTest{
+			literal: "\n  1.5, -2\n  3e2\t0\n\n",
+			matrix: dense.New(2, 2)(
+				1.5, -2,
+				300, 0,
+			),
+		},
+		&parseTest{
+			literal: "7;",
+			matrix:  dense.New(1, 1)(7),
+		},
+		&parseTest{
+			literal: "1; 2; 3",
+			matrix:  dense.New(3, 1)(1, 2, 3),
+		},
+	}
+
+	for _, test := range tests {
+		m, err := Parse(test.literal)
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred for %q.", err, test.literal)
+		}
+
+		if !m.Equal(test.matrix) {
+			t.Fatalf("The matrix parsed from %q is wrong.", test.literal)
+		}
+	}
+}
+
+type parseErrorTest struct {
+	literal string
+	error   string
+}
+
+func TestParseFailsForInvalidLiteral(t *testing.T) {
+	tests := []*parseErrorTest{
+		&parseErrorTest{literal: "", error: EmptyLiteralError},
+		&parseErrorTest{literal: " ; \n", error: EmptyLiteralError},
+		&parseErrorTest{literal: "1 2; 3", error: RaggedLiteralError},
+		&parseErrorTest{literal: "1 two", error: InvalidLiteralError},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.literal)
+
+		if err == nil || err.Error() != test.error {
+			t.Fatalf("The error %s should occur for %q, but %v occurred.", test.error, test.literal, err)
+		}
+	}
+}
+
+func TestMustParseCausesPanicForInvalidLiteral(t *testing.T) {
+	defer func() {
+		if p, ok := recover().(error); ok && p.Error() == RaggedLiteralError {
+			return
+		}
+
+		t.Fatalf("The invalid literal should cause %s.", RaggedLiteralError)
+	}()
+
+	MustParse("1 2; 3")
+}
+
+func TestMustParseMutableDense(t *testing.T) {
+	if MustParse("1 0; 0 1").Equal(scaledIdentity(2, 1)) {
+		return
+	}
+
+	t.Fatal("The matrix parsed from the literal is wrong.")
+}
@@ -0,0 +1,76 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Create a new matrix evaluating the polynomial "coeffs[0] I + coeffs[1] a + ... + coeffs[k] a^k".
+// The evaluation follows Paterson-Stockmeyer,
+// which needs about 2 sqrt(k) multiplications instead of k for Horner's method.
+// When "coeffs" is empty, zero matrix is returned.
+// When "a" is not square, validates.NOT_SQUARE_PANIC will be caused.
+func PolyEval(coeffs []float64, a Matrix) Matrix {
+	validates.ShapeShouldBeSquare(a)
+
+	size := a.Rows()
+
+	if len(coeffs) == 0 {
+		return dense.Zeros(size, size)
+	}
+
+	degree := len(coeffs) - 1
+	step := int(math.Ceil(math.Sqrt(float64(degree + 1))))
+
+	// "powers[i]" is "a^i" for 0 <= i <= "step".
+	powers := make([]Matrix, step+1)
+	powers[0] = scaledIdentity(size, 1)
+
+	for i := 1; i <= step; i++ {
+		powers[i] = powers[i-1].Multiply(a)
+	}
+
+	block := func(j int) Matrix {
+		b := dense.Zeros(size, size)
+
+		for i := 0; i < step && j*step+i <= degree; i++ {
+			if c := coeffs[j*step+i]; c != 0 {
+				addScaled(b, powers[i], c)
+			}
+		}
+
+		return b
+	}
+
+	blocks := degree / step
+	p := block(blocks)
+
+	for j := blocks - 1; j >= 0; j-- {
+		p = p.Multiply(powers[step]).Add(block(j))
+	}
+
+	return p
+}
+
+// Create a new "size x size" matrix which has "c" on the diagonal.
+func scaledIdentity(size int, c float64) Matrix {
+	m := dense.Zeros(size, size)
+
+	for i := 0; i < size; i++ {
+		m.Update(i, i, c)
+	}
+
+	return m
+}
+
+// Add "c" times "m" to "r" without modifying "m".
+func addScaled(r, m Matrix, c float64) {
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		r.Update(row, column, r.Get(row, column)+c*element)
+	}
+}
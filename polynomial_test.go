@@ -0,0 +1,85 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Evaluate the polynomial by repeated multiplication to compare with "PolyEval".
+func polyEvalNaively(coeffs []float64, a Matrix) Matrix {
+	r := dense.Zeros(a.Rows(), a.Columns())
+	power := scaledIdentity(a.Rows(), 1)
+
+	for _, c := range coeffs {
+		addScaled(r, power, c)
+		power = power.Multiply(a)
+	}
+
+	return r
+}
+
+func TestPolyEvalMutableDense(t *testing.T) {
+	a := dense.New(3, 3)(
+		0.5, -1, 0,
+		0.25, 0, 1,
+		-0.5, 0.75, 0.5,
+	)
+
+	coeffs := []float64{1, -2, 0.5, 3, 0, -1, 0.25, 2, -0.5, 1.5, 0.125}
+
+	for degree := 0; degree < len(coeffs); degree++ {
+		p := PolyEval(coeffs[:degree+1], a)
+		r := polyEvalNaively(coeffs[:degree+1], a)
+
+		if !p.EqualApprox(r, 1e-12) {
+			t.Fatalf("The polynomial of degree %d is evaluated wrongly.", degree)
+		}
+	}
+}
+
+func TestPolyEvalWithoutCoefficientsMutableDense(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	if IsZeros(PolyEval(nil, a)) {
+		return
+	}
+
+	t.Fatal("The polynomial without coefficients should be zeros.")
+}
+
+func TestPolyEvalKeepsTheArgumentMutableDense(t *testing.T) {
+	a := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	r := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	PolyEval([]float64{1, 2, 3, 4, 5}, a)
+
+	if a.Equal(r) {
+		return
+	}
+
+	t.Fatal("The matrix should not be modified by the evaluation.")
+}
+
+func TestPolyEvalCausesPanicForNonSquareMutableDense(t *testing.T) {
+	defer func() {
+		if p := recover(); p == validates.NOT_SQUARE_PANIC {
+			return
+		}
+
+		t.Fatalf("Non-square matrix should cause %s.", validates.NOT_SQUARE_PANIC)
+	}()
+
+	PolyEval([]float64{1, 2}, dense.Zeros(2, 3))
+}
@@ -0,0 +1,92 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Compute the Householder reflector P = I - beta * v * v^T
+// which maps the column vector "x" to (|x|, 0, ..., 0)^T.
+// The returned "v" is a new column vector normalized as v[0] = 1.
+func Householder(x Matrix) (v Matrix, beta float64) {
+	rows := x.Rows()
+
+	r := dense.Zeros(rows, 1)
+	r.Update(0, 0, 1)
+
+	x0 := x.Get(0, 0)
+
+	var sigma float64
+	for row := 1; row < rows; row++ {
+		element := x.Get(row, 0)
+		sigma += element * element
+		r.Update(row, 0, element)
+	}
+
+	if sigma == 0 {
+		if x0 >= 0 {
+			return r, 0
+		}
+
+		return r, 2
+	}
+
+	mu := math.Sqrt(x0*x0 + sigma)
+
+	var v0 float64
+	if x0 <= 0 {
+		v0 = x0 - mu
+	} else {
+		v0 = -sigma / (x0 + mu)
+	}
+
+	beta = 2 * v0 * v0 / (sigma + v0*v0)
+
+	r.Scalar(1 / v0)
+	r.Update(0, 0, 1)
+
+	return r, beta
+}
+
+// Apply the Householder reflector P = I - beta * v * v^T to "m" from the left.
+// When "m" is mutable, the elements of "m" are rewritten and "m" is returned.
+// When the number of rows of "v" doesn't equal to the one of "m",
+// validates.NOT_MULTIPLIABLE_PANIC will be caused.
+func ApplyHouseholder(v Matrix, beta float64, m Matrix) Matrix {
+	validates.ShapeShouldBeMultipliable(v.Transpose(), m)
+
+	w := v.Transpose().Multiply(m)
+
+	return m.Subtract(v.Multiply(w).Scalar(beta))
+}
+
+// Compute the Givens rotation [c s; -s c] which maps (a, b)^T to (r, 0)^T.
+func Givens(a, b float64) (c, s, r float64) {
+	if b == 0 {
+		return 1, 0, a
+	}
+
+	r = math.Hypot(a, b)
+
+	return a / r, b / r, r
+}
+
+// Apply the Givens rotation [c s; -s c] to the "i"-th and "k"-th rows of "m".
+// When "m" is mutable, the elements of "m" are rewritten and "m" is returned.
+// When "i" or "k" is out of the rows of "m",
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func ApplyGivens(m Matrix, i, k int, c, s float64) Matrix {
+	validates.IndexShouldBeInRange(m.Rows(), 1, i, 0)
+	validates.IndexShouldBeInRange(m.Rows(), 1, k, 0)
+
+	for column := 0; column < m.Columns(); column++ {
+		x, y := m.Get(i, column), m.Get(k, column)
+
+		m.Update(i, column, c*x+s*y)
+		m.Update(k, column, -s*x+c*y)
+	}
+
+	return m
+}
@@ -0,0 +1,81 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestHouseholderMapsVectorToAxisMutableDense(t *testing.T) {
+	tests := []Matrix{
+		dense.New(3, 1)(3, 4, 0),
+		dense.New(3, 1)(-3, 0, 4),
+		dense.New(2, 1)(-2, 0),
+		dense.New(2, 1)(2, 0),
+	}
+
+	results := []Matrix{
+		dense.New(3, 1)(5, 0, 0),
+		dense.New(3, 1)(5, 0, 0),
+		dense.New(2, 1)(2, 0),
+		dense.New(2, 1)(2, 0),
+	}
+
+	for i, x := range tests {
+		v, beta := Householder(x)
+
+		if v.Get(0, 0) != 1 {
+			t.Fatal("The first element of the Householder vector should be 1.")
+		}
+
+		if r := ApplyHouseholder(v, beta, x); !r.EqualApprox(results[i], 1e-12) {
+			t.Fatalf("The %d-th vector should be mapped to the first axis.", i)
+		}
+	}
+}
+
+func TestApplyHouseholderCausesPanicForDifferentRowsMutableDense(t *testing.T) {
+	v := dense.New(3, 1)(1, 0, 0)
+	m := dense.Zeros(2, 2)
+
+	defer func() {
+		if p := recover(); p == validates.NOT_MULTIPLIABLE_PANIC {
+			return
+		}
+
+		t.Fatalf("The reflector of different rows should cause %s.", validates.NOT_MULTIPLIABLE_PANIC)
+	}()
+	ApplyHouseholder(v, 2, m)
+}
+
+func TestGivensEliminatesTheSecondElement(t *testing.T) {
+	c, s, r := Givens(3, 4)
+
+	if math.Abs(r-5) > 1e-12 || math.Abs(-s*3+c*4) > 1e-12 || math.Abs(c*3+s*4-r) > 1e-12 {
+		t.Fatalf("The Givens rotation (c = %v, s = %v, r = %v) is wrong.", c, s, r)
+	}
+}
+
+func TestApplyGivensRotatesRowsMutableDense(t *testing.T) {
+	m := dense.New(3, 2)(
+		3, 1,
+		9, 9,
+		4, 2,
+	)
+
+	c, s, _ := Givens(3, 4)
+
+	r := dense.New(3, 2)(
+		5, 2.2,
+		9, 9,
+		0, 0.4,
+	)
+
+	if ApplyGivens(m, 0, 2, c, s).EqualApprox(r, 1e-12) {
+		return
+	}
+
+	t.Fatal("The Givens rotation should be applied to the specified rows.")
+}
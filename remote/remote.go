@@ -0,0 +1,226 @@
+/*
+Package "remote" provides a read-only matrix stored in the tiled format of "encode/tiled" on an HTTP server.
+The tiles are fetched lazily with range requests, so a slice of a huge matrix is read
+without downloading the whole file.
+*/
+package remote
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/encode/tiled"
+	"github.com/mitsuse/matrix-go/internal/validates"
+	"github.com/mitsuse/matrix-go/shape"
+)
+
+const (
+	RangeNotSupportedError = "RangeNotSupportedError"
+)
+
+const (
+	// The number of tiles kept by default.
+	defaultCacheSize = 64
+)
+
+/*
+"Matrix" is a read-only matrix whose tiles are fetched from "URL" on demand.
+The fetched tiles are cached up to "CacheSize" tiles, and the oldest one is evicted first.
+"Matrix" is safe for concurrent use.
+*/
+type Matrix struct {
+	reader *rangeReader
+	header *tiled.Header
+
+	mutex     sync.Mutex
+	tiles     map[int][]float64
+	order     []int
+	CacheSize int
+}
+
+// Open the matrix at "url", reading only the header and the index of tiles.
+// When "client" is nil, http.DefaultClient is used.
+// When the server does not respond to range requests, RangeNotSupportedError is returned.
+func Open(client *http.Client, url string) (*Matrix, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	reader := &rangeReader{
+		client: client,
+		url:    url,
+	}
+
+	header, err := tiled.ReadHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Matrix{
+		reader:    reader,
+		header:    header,
+		tiles:     make(map[int][]float64),
+		CacheSize: defaultCacheSize,
+	}
+
+	return m, nil
+}
+
+func (m *Matrix) Shape() (rows, columns int) {
+	return m.header.Rows, m.header.Columns
+}
+
+func (m *Matrix) Rows() (rows int) {
+	return m.header.Rows
+}
+
+func (m *Matrix) Columns() (columns int) {
+	return m.header.Columns
+}
+
+// Get an element of the matrix, fetching the tile containing it when it is not cached.
+// When "row" or "column" is out of range, validates.OUT_OF_RANGE_PANIC will be caused.
+func (m *Matrix) Get(row, column int) (float64, error) {
+	i := m.header.TileAt(row, column)
+
+	elements, err := m.tile(i)
+	if err != nil {
+		return 0, err
+	}
+
+	t := m.header.Tiles[i]
+
+	return elements[(row-t.Row)*t.Columns+column-t.Column], nil
+}
+
+// Create a new dense matrix copying the "rows x columns" region at ("row", "column"),
+// fetching only the tiles overlapping with it.
+// When the region is not in the matrix, validates.INVALID_VIEW_PANIC will be caused.
+func (m *Matrix) View(row, column, rows, columns int) (*dense.Matrix, error) {
+	validates.ShapeShouldBePositive(rows, columns)
+	validates.ViewShouldBeInBase(
+		shape.NewShape(m.header.Rows, m.header.Columns),
+		shape.NewShape(rows, columns),
+		shape.NewIndex(row, column),
+	)
+
+	r := dense.Zeros(rows, columns)
+
+	for i, t := range m.header.Tiles {
+		top, bottom := maxInt(t.Row, row), minInt(t.Row+t.Rows, row+rows)
+		left, right := maxInt(t.Column, column), minInt(t.Column+t.Columns, column+columns)
+
+		if top >= bottom || left >= right {
+			continue
+		}
+
+		elements, err := m.tile(i)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := top; j < bottom; j++ {
+			for k := left; k < right; k++ {
+				r.Update(j-row, k-column, elements[(j-t.Row)*t.Columns+k-t.Column])
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// Return the elements of the "i"-th tile from the cache or the server.
+func (m *Matrix) tile(i int) ([]float64, error) {
+	m.mutex.Lock()
+	elements, cached := m.tiles[i]
+	m.mutex.Unlock()
+
+	if cached {
+		return elements, nil
+	}
+
+	elements, err := m.header.ReadTile(m.reader, i)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, cached := m.tiles[i]; !cached && m.CacheSize > 0 {
+		for len(m.order) >= m.CacheSize {
+			delete(m.tiles, m.order[0])
+			m.order = m.order[1:]
+		}
+
+		m.tiles[i] = elements
+		m.order = append(m.order, i)
+	}
+
+	return elements, nil
+}
+
+/*
+"rangeReader" reads a file on an HTTP server with range requests.
+*/
+type rangeReader struct {
+	client *http.Client
+	url    string
+}
+
+func (r *rangeReader) ReadAt(b []byte, offset int64) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	request, err := http.NewRequest("GET", r.url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+int64(len(b))-1))
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusRequestedRangeNotSatisfiable:
+		return 0, io.EOF
+	case http.StatusOK:
+		// The body is the whole file, so it is closed without being read.
+		return 0, errors.New(RangeNotSupportedError)
+	default:
+		return 0, fmt.Errorf("failed to read %s: %s", r.url, response.Status)
+	}
+
+	n, err := io.ReadFull(response.Body, b)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+
+	return n, err
+}
+
+func minInt(x, y int) int {
+	if x < y {
+		return x
+	}
+
+	return y
+}
+
+func maxInt(x, y int) int {
+	if x > y {
+		return x
+	}
+
+	return y
+}
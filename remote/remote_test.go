@@ -0,0 +1,195 @@
+package remote
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/encode/tiled"
+)
+
+// Create a "rows x columns" matrix, the elements of which are distinct.
+func sequence(rows, columns int) *dense.Matrix {
+	elements := make([]float64, rows*columns)
+	for index := range elements {
+		elements[index] = float64(index)
+	}
+
+	return dense.New(rows, columns)(elements...)
+}
+
+/*
+"server" serves a tiled matrix and counts the requests.
+*/
+type server struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	requests int
+}
+
+func serve(m *dense.Matrix, tileRows, tileColumns int) *server {
+	buffer := &bytes.Buffer{}
+	tiled.Write(buffer, m, tileRows, tileColumns, 1)
+
+	b := buffer.Bytes()
+	s := &server{}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		s.requests++
+		s.mutex.Unlock()
+
+		http.ServeContent(w, r, "matrix", time.Time{}, bytes.NewReader(b))
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(handler))
+
+	return s
+}
+
+func (s *server) count() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.requests
+}
+
+func TestGetFetchesTilesLazily(t *testing.T) {
+	m := sequence(10, 12)
+
+	s := serve(m, 4, 5)
+	defer s.Close()
+
+	r, err := Open(nil, s.URL)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if rows, columns := r.Shape(); rows != 10 || columns != 12 {
+		t.Fatalf("The shape should be 10x12, but is %dx%d.", rows, columns)
+	}
+
+	opened := s.count()
+
+	for _, index := range [][]int{{0, 0}, {3, 4}, {1, 2}, {9, 11}} {
+		element, err := r.Get(index[0], index[1])
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if element != m.Get(index[0], index[1]) {
+			t.Fatalf("The element at (%d, %d) is wrong.", index[0], index[1])
+		}
+	}
+
+	// The first three elements are in the same tile.
+	if requests := s.count() - opened; requests != 2 {
+		t.Fatalf("Two tiles should be fetched, but %d requests are sent.", requests)
+	}
+}
+
+func TestViewReturnsTheRegion(t *testing.T) {
+	m := sequence(10, 12)
+
+	s := serve(m, 4, 5)
+	defer s.Close()
+
+	r, err := Open(nil, s.URL)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	opened := s.count()
+
+	v, err := r.View(3, 4, 2, 3)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if !v.Equal(m.View(3, 4, 2, 3)) {
+		t.Fatal("The view of remote matrix is wrong.")
+	}
+
+	// The region spans 2x2 tiles.
+	if requests := s.count() - opened; requests != 4 {
+		t.Fatalf("Four tiles should be fetched, but %d requests are sent.", requests)
+	}
+}
+
+func TestCacheEvictsTheOldestTile(t *testing.T) {
+	s := serve(sequence(4, 4), 2, 2)
+	defer s.Close()
+
+	r, err := Open(nil, s.URL)
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	r.CacheSize = 1
+
+	r.Get(0, 0)
+	r.Get(3, 3)
+
+	opened := s.count()
+
+	r.Get(3, 3)
+	r.Get(0, 0)
+
+	if requests := s.count() - opened; requests != 1 {
+		t.Fatalf("Only the evicted tile should be fetched again, but %d requests are sent.", requests)
+	}
+}
+
+func TestOpenFailsWithoutRangeRequests(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	tiled.Write(buffer, sequence(2, 2), 1, 1, 1)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write(buffer.Bytes())
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(handler))
+	defer s.Close()
+
+	if _, err := Open(nil, s.URL); err == nil || err.Error() != RangeNotSupportedError {
+		t.Fatalf("The server without range requests should cause %s, but %v occurred.", RangeNotSupportedError, err)
+	}
+}
+
+func TestOpenDoesNotDownloadWholeFileWithoutRangeRequests(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	tiled.Write(buffer, sequence(2, 2), 1, 1, 1)
+
+	release := make(chan bool)
+
+	// The rest of the file is sent only after "release" is closed.
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write(buffer.Bytes())
+		w.(http.Flusher).Flush()
+
+		select {
+		case <-release:
+		case <-time.After(10 * time.Second):
+		}
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(handler))
+	defer s.Close()
+
+	begin := time.Now()
+	_, err := Open(nil, s.URL)
+	close(release)
+
+	if err == nil || err.Error() != RangeNotSupportedError {
+		t.Fatalf("The server without range requests should cause %s, but %v occurred.", RangeNotSupportedError, err)
+	}
+
+	if time.Since(begin) > 5*time.Second {
+		t.Fatal("The response without range should not be read until the end.")
+	}
+}
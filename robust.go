@@ -0,0 +1,107 @@
+package matrix
+
+import (
+	"sort"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+const (
+	// The factor making the median absolute deviation consistent with the standard deviation of normal distribution.
+	madScale = 1.4826
+)
+
+// Create a "1 x columns" row vector, the j-th element of which is the median of the j-th column of "m".
+// For a column of even length, the mean of the two middle elements is used.
+func MedianColumns(m Matrix) Matrix {
+	r := dense.Zeros(1, m.Columns())
+
+	for column, elements := range sortedColumns(m) {
+		r.Update(0, column, median(elements))
+	}
+
+	return r
+}
+
+// Create a "1 x columns" row vector, the j-th element of which is the median absolute deviation
+// of the j-th column of "m" from its median.
+func MADColumns(m Matrix) Matrix {
+	r := dense.Zeros(1, m.Columns())
+
+	for column, elements := range sortedColumns(m) {
+		r.Update(0, column, mad(elements))
+	}
+
+	return r
+}
+
+// Create a new matrix standardizing each column of "m" as "(x - median) / (1.4826 MAD)",
+// which is not affected by a small number of outliers unlike the mean and the standard deviation.
+// The 1.4826 makes the scale consistent with the standard deviation for normally distributed data.
+// Columns with zero MAD are only centered.
+func RobustStandardize(m Matrix) Matrix {
+	rows, columns := m.Shape()
+	r := dense.Zeros(rows, columns)
+
+	for column, elements := range sortedColumns(m) {
+		center := median(elements)
+
+		scale := madScale * mad(elements)
+		if scale == 0 {
+			scale = 1
+		}
+
+		for row := 0; row < rows; row++ {
+			r.Update(row, column, (m.Get(row, column)-center)/scale)
+		}
+	}
+
+	return r
+}
+
+// Return the elements of each column of "m" in ascending order.
+func sortedColumns(m Matrix) [][]float64 {
+	rows, columns := m.Shape()
+	sorted := make([][]float64, columns)
+
+	for column := range sorted {
+		elements := make([]float64, rows)
+		for row := range elements {
+			elements[row] = m.Get(row, column)
+		}
+
+		sort.Float64s(elements)
+		sorted[column] = elements
+	}
+
+	return sorted
+}
+
+// Return the median of "sorted" elements.
+func median(sorted []float64) float64 {
+	middle := len(sorted) / 2
+
+	if len(sorted)%2 == 1 {
+		return sorted[middle]
+	}
+
+	return (sorted[middle-1] + sorted[middle]) / 2
+}
+
+// Return the median absolute deviation of "sorted" elements from their median.
+func mad(sorted []float64) float64 {
+	center := median(sorted)
+
+	deviations := make([]float64, len(sorted))
+	for i, element := range sorted {
+		if element < center {
+			deviations[i] = center - element
+		} else {
+			deviations[i] = element - center
+		}
+	}
+
+	sort.Float64s(deviations)
+
+	return median(deviations)
+}
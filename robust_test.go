@@ -0,0 +1,65 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestMedianColumnsMutableDense(t *testing.T) {
+	m := dense.New(4, 2)(
+		3, 10,
+		1, -2,
+		100, 4,
+		2, 0,
+	)
+
+	r := dense.New(1, 2)(2.5, 2)
+
+	if MedianColumns(m).Equal(r) && MedianColumns(m.View(0, 0, 3, 2)).Equal(dense.New(1, 2)(3, 4)) {
+		return
+	}
+
+	t.Fatal("The medians of columns are wrong.")
+}
+
+func TestMADColumnsMutableDense(t *testing.T) {
+	m := dense.New(5, 1)(
+		1,
+		2,
+		3,
+		4,
+		1000,
+	)
+
+	// The deviations from the median 3 are 2, 1, 0, 1 and 997.
+	if MADColumns(m).Equal(dense.New(1, 1)(1)) {
+		return
+	}
+
+	t.Fatal("The median absolute deviation is wrong.")
+}
+
+func TestRobustStandardizeMutableDense(t *testing.T) {
+	m := dense.New(5, 2)(
+		1, 7,
+		2, 7,
+		3, 7,
+		4, 7,
+		1000, 8,
+	)
+
+	r := dense.New(5, 2)(
+		-2/madScale, 0,
+		-1/madScale, 0,
+		0, 0,
+		1/madScale, 0,
+		997/madScale, 1,
+	)
+
+	if RobustStandardize(m).EqualApprox(r, 1e-12) {
+		return
+	}
+
+	t.Fatal("The robust standardization is wrong.")
+}
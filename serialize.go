@@ -0,0 +1,72 @@
+package matrix
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/types"
+)
+
+const (
+	UnknownFormatError = "UnknownFormatError"
+)
+
+const (
+	gzipMagic = "\x1f\x8b"
+)
+
+// Deserialize a matrix from the given reader, detecting the format from the first bytes.
+// This accepts data written by "(Matrix).Serialize", "dense.SerializeCompressed" and "dense.SerializeChunked",
+// and JSON may start with whitespace as "dense.Deserialize" accepts.
+// The formats of "dense.SerializeIndexed" and MessagePack are not detected,
+// because the former is read with "io.ReaderAt" and the latter has no magic.
+// When the format is not recognized, UnknownFormatError is returned.
+func Deserialize(reader io.Reader) (Matrix, error) {
+	r := bufio.NewReader(reader)
+
+	// The error is ignored because a short prefix matches none of the formats.
+	prefix, _ := r.Peek(len(dense.ChunkedMagic))
+
+	var deserialize func(reader io.Reader) (types.Matrix, error)
+
+	switch {
+	case startsWithObject(r):
+		deserialize = dense.Deserialize
+	case bytes.HasPrefix(prefix, []byte(gzipMagic)):
+		deserialize = dense.DeserializeCompressed
+	case bytes.HasPrefix(prefix, []byte(dense.ChunkedMagic)):
+		deserialize = dense.DeserializeChunked
+	default:
+		return nil, errors.New(UnknownFormatError)
+	}
+
+	m, err := deserialize(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Check whether "r" starts with "{" after JSON whitespace without consuming any byte.
+// Whitespace longer than the buffer of "r" is not skipped.
+func startsWithObject(r *bufio.Reader) bool {
+	for n := 1; ; n++ {
+		b, err := r.Peek(n)
+		if err != nil {
+			return false
+		}
+
+		switch b[n-1] {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '{':
+			return true
+		default:
+			return false
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestDeserializeMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 1, 2,
+		3, 4, 5,
+	)
+
+	serializers := []func(writer io.Writer) error{
+		m.Serialize,
+		func(writer io.Writer) error { return dense.SerializeCompressed(writer, m) },
+		func(writer io.Writer) error { return dense.SerializeChunked(writer, m, 1) },
+	}
+
+	// JSON may start with whitespace.
+	serializers = append(serializers, func(writer io.Writer) error {
+		io.WriteString(writer, " \n\t")
+		return m.Serialize(writer)
+	})
+
+	for index, serialize := range serializers {
+		buffer := &bytes.Buffer{}
+
+		if err := serialize(buffer); err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		n, err := Deserialize(buffer)
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred for the format %d.", err, index)
+		}
+
+		if !n.Equal(m) {
+			t.Fatalf("The matrix deserialized from the format %d should equal to the original.", index)
+		}
+	}
+}
+
+func TestDeserializeFailsForUnknownFormat(t *testing.T) {
+	tests := []string{
+		"",
+		"[1, 2]",
+		"\x93NUMPY",
+		" \n[1, 2]",
+		dense.IndexedMagic,
+	}
+
+	for _, test := range tests {
+		if _, err := Deserialize(bytes.NewBufferString(test)); err == nil || err.Error() != UnknownFormatError {
+			t.Fatalf("The unknown format %q should cause %s, but %v occurred.", test, UnknownFormatError, err)
+		}
+	}
+}
+
+func TestDeserializeFailsForTruncatedHugeChunk(t *testing.T) {
+	b := &bytes.Buffer{}
+	b.WriteString(dense.ChunkedMagic)
+	binary.Write(b, binary.LittleEndian, []uint64{math.MaxInt32, 4, math.MaxInt32, 4 * math.MaxInt32})
+
+	_, err := Deserialize(b)
+	if _, ok := err.(*dense.CorruptedDataError); ok {
+		return
+	}
+
+	t.Fatalf("The truncated chunk should cause CorruptedDataError, but %v occurred.", err)
+}
@@ -1,14 +1,19 @@
-package types
+package shape
 
 import (
 	"encoding/json"
 )
 
+/*
+"Index" is the pair of the row and the column of an element.
+It is serialized as {"rows": row, "columns": column} in JSON for compatibility.
+*/
 type Index struct {
 	row    int
 	column int
 }
 
+// Create a new index of ("row", "column").
 func NewIndex(row, column int) *Index {
 	i := &Index{
 		row:    row,
@@ -18,10 +23,12 @@ func NewIndex(row, column int) *Index {
 	return i
 }
 
+// Return the row.
 func (i *Index) Row() int {
 	return i.row
 }
 
+// Return the column.
 func (i *Index) Column() int {
 	return i.column
 }
@@ -1,14 +1,23 @@
-package types
+/*
+Package "shape" provides the shape and the index of matrices,
+which are used by implementations of matrix types and kept by serialization.
+*/
+package shape
 
 import (
 	"encoding/json"
 )
 
+/*
+"Shape" is the pair of the numbers of rows and columns.
+It is serialized as {"rows": rows, "columns": columns} in JSON.
+*/
 type Shape struct {
 	rows    int
 	columns int
 }
 
+// Create a new shape of "rows" x "columns".
 func NewShape(rows, columns int) *Shape {
 	s := &Shape{
 		rows:    rows,
@@ -18,10 +27,12 @@ func NewShape(rows, columns int) *Shape {
 	return s
 }
 
+// Return the number of rows.
 func (s *Shape) Rows() int {
 	return s.rows
 }
 
+// Return the number of columns.
 func (s *Shape) Columns() int {
 	return s.columns
 }
@@ -0,0 +1,57 @@
+package shape
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestShapeRetainsRowsAndColumns(t *testing.T) {
+	rows, columns := 2, 1
+
+	s := NewShape(rows, columns)
+
+	if s.Rows() == rows && s.Columns() == columns {
+		return
+	}
+
+	t.Fatal("Shape should retains the rows and columns equivalent to the given ones.")
+}
+
+func TestIndexRetainsRowAndColumn(t *testing.T) {
+	row, column := 1, 0
+
+	i := NewIndex(row, column)
+
+	if i.Row() == row && i.Column() == column {
+		return
+	}
+
+	t.Fatal("Index should retains the row and column equivalent to the given ones.")
+}
+
+func TestShapeAndIndexRoundTripJson(t *testing.T) {
+	b, err := json.Marshal([]interface{}{NewShape(2, 3), NewIndex(1, 0)})
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if string(b) != `[{"rows":2,"columns":3},{"rows":1,"columns":0}]` {
+		t.Fatalf("The JSON of the shape and the index is wrong: %s", b)
+	}
+
+	objects := []json.RawMessage{}
+	if err := json.Unmarshal(b, &objects); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	s, i := &Shape{}, &Index{}
+	if json.Unmarshal(objects[0], s) != nil || json.Unmarshal(objects[1], i) != nil {
+		t.Fatal("The shape and the index should be unmarshaled without any error.")
+	}
+
+	if s.Rows() == 2 && s.Columns() == 3 && i.Row() == 1 && i.Column() == 0 {
+		return
+	}
+
+	t.Fatal("The shape and the index should be restored from JSON.")
+}
@@ -0,0 +1,126 @@
+package matrix
+
+/*
+"small" holds the elements of a square matrix of size 4 at most in row-major order.
+*/
+type small struct {
+	size int
+	a    [4][4]float64
+}
+
+// Copy the elements of "m" of size 2, 3 or 4.
+// When "m" is not such a square matrix, false is returned.
+func toSmall(m Matrix) (*small, bool) {
+	size := m.Rows()
+
+	if size != m.Columns() || size < 2 || 4 < size {
+		return nil, false
+	}
+
+	s := &small{size: size}
+
+	for row := 0; row < size; row++ {
+		for column := 0; column < size; column++ {
+			s.a[row][column] = m.Get(row, column)
+		}
+	}
+
+	return s, true
+}
+
+// Return the determinant in the closed form.
+func (s *small) determinant() float64 {
+	a := &s.a
+
+	switch s.size {
+	case 2:
+		return a[0][0]*a[1][1] - a[0][1]*a[1][0]
+	case 3:
+		return a[0][0]*(a[1][1]*a[2][2]-a[1][2]*a[2][1]) -
+			a[0][1]*(a[1][0]*a[2][2]-a[1][2]*a[2][0]) +
+			a[0][2]*(a[1][0]*a[2][1]-a[1][1]*a[2][0])
+	}
+
+	d, _, _ := s.minors4()
+
+	return d
+}
+
+// Return the determinant of 4x4 matrix and the 2x2 minors of the upper and lower halves.
+func (s *small) minors4() (d float64, upper, lower [6]float64) {
+	a := &s.a
+
+	upper = [6]float64{
+		a[0][0]*a[1][1] - a[1][0]*a[0][1],
+		a[0][0]*a[1][2] - a[1][0]*a[0][2],
+		a[0][0]*a[1][3] - a[1][0]*a[0][3],
+		a[0][1]*a[1][2] - a[1][1]*a[0][2],
+		a[0][1]*a[1][3] - a[1][1]*a[0][3],
+		a[0][2]*a[1][3] - a[1][2]*a[0][3],
+	}
+
+	lower = [6]float64{
+		a[2][0]*a[3][1] - a[3][0]*a[2][1],
+		a[2][0]*a[3][2] - a[3][0]*a[2][2],
+		a[2][0]*a[3][3] - a[3][0]*a[2][3],
+		a[2][1]*a[3][2] - a[3][1]*a[2][2],
+		a[2][1]*a[3][3] - a[3][1]*a[2][3],
+		a[2][2]*a[3][3] - a[3][2]*a[2][3],
+	}
+
+	s0, s1, s2, s3, s4, s5 := upper[0], upper[1], upper[2], upper[3], upper[4], upper[5]
+	c0, c1, c2, c3, c4, c5 := lower[0], lower[1], lower[2], lower[3], lower[4], lower[5]
+
+	d = s0*c5 - s1*c4 + s2*c3 + s3*c2 - s4*c1 + s5*c0
+
+	return d, upper, lower
+}
+
+// Return the adjugate in the closed form and the determinant.
+func (s *small) adjugate() (b [4][4]float64, d float64) {
+	a := &s.a
+
+	switch s.size {
+	case 2:
+		b[0][0], b[0][1] = a[1][1], -a[0][1]
+		b[1][0], b[1][1] = -a[1][0], a[0][0]
+
+		return b, s.determinant()
+	case 3:
+		b[0][0] = a[1][1]*a[2][2] - a[1][2]*a[2][1]
+		b[0][1] = a[0][2]*a[2][1] - a[0][1]*a[2][2]
+		b[0][2] = a[0][1]*a[1][2] - a[0][2]*a[1][1]
+		b[1][0] = a[1][2]*a[2][0] - a[1][0]*a[2][2]
+		b[1][1] = a[0][0]*a[2][2] - a[0][2]*a[2][0]
+		b[1][2] = a[0][2]*a[1][0] - a[0][0]*a[1][2]
+		b[2][0] = a[1][0]*a[2][1] - a[1][1]*a[2][0]
+		b[2][1] = a[0][1]*a[2][0] - a[0][0]*a[2][1]
+		b[2][2] = a[0][0]*a[1][1] - a[0][1]*a[1][0]
+
+		return b, a[0][0]*b[0][0] + a[0][1]*b[1][0] + a[0][2]*b[2][0]
+	}
+
+	d, upper, lower := s.minors4()
+
+	s0, s1, s2, s3, s4, s5 := upper[0], upper[1], upper[2], upper[3], upper[4], upper[5]
+	c0, c1, c2, c3, c4, c5 := lower[0], lower[1], lower[2], lower[3], lower[4], lower[5]
+
+	b[0][0] = a[1][1]*c5 - a[1][2]*c4 + a[1][3]*c3
+	b[0][1] = -a[0][1]*c5 + a[0][2]*c4 - a[0][3]*c3
+	b[0][2] = a[3][1]*s5 - a[3][2]*s4 + a[3][3]*s3
+	b[0][3] = -a[2][1]*s5 + a[2][2]*s4 - a[2][3]*s3
+	b[1][0] = -a[1][0]*c5 + a[1][2]*c2 - a[1][3]*c1
+	b[1][1] = a[0][0]*c5 - a[0][2]*c2 + a[0][3]*c1
+	b[1][2] = -a[3][0]*s5 + a[3][2]*s2 - a[3][3]*s1
+	b[1][3] = a[2][0]*s5 - a[2][2]*s2 + a[2][3]*s1
+	b[2][0] = a[1][0]*c4 - a[1][1]*c2 + a[1][3]*c0
+	b[2][1] = -a[0][0]*c4 + a[0][1]*c2 - a[0][3]*c0
+	b[2][2] = a[3][0]*s4 - a[3][1]*s2 + a[3][3]*s0
+	b[2][3] = -a[2][0]*s4 + a[2][1]*s2 - a[2][3]*s0
+	b[3][0] = -a[1][0]*c3 + a[1][1]*c1 - a[1][2]*c0
+	b[3][1] = a[0][0]*c3 - a[0][1]*c1 + a[0][2]*c0
+	b[3][2] = -a[3][0]*s3 + a[3][1]*s1 - a[3][2]*s0
+	b[3][3] = a[2][0]*s3 - a[2][1]*s1 + a[2][2]*s0
+
+	return b, d
+}
@@ -0,0 +1,73 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// Create a well-conditioned "size x size" matrix with distinct elements.
+func smallForTest(size int) Matrix {
+	m := dense.Zeros(size, size)
+
+	for row := 0; row < size; row++ {
+		for column := 0; column < size; column++ {
+			m.Update(row, column, math.Sin(float64(row*size+column+1)))
+		}
+
+		m.Update(row, row, m.Get(row, row)+float64(size))
+	}
+
+	return m
+}
+
+func TestDeterminantOfSmallEqualsToEliminationMutableDense(t *testing.T) {
+	for size := 2; size <= 4; size++ {
+		m := smallForTest(size)
+
+		d := Determinant(m)
+		e := determinant(rowsExcept(m, -1, -1))
+
+		if math.Abs(d-e) > 1e-12*math.Abs(e) {
+			t.Fatalf("The determinant of %dx%d matrix should be %v, but is %v.", size, size, e, d)
+		}
+	}
+}
+
+func TestInverseMutableDense(t *testing.T) {
+	for size := 1; size <= 6; size++ {
+		m := smallForTest(size)
+
+		n, err := Inverse(m)
+		if err != nil {
+			t.Fatalf("An unexpected error %q occurred.", err)
+		}
+
+		if !m.Multiply(n).EqualApprox(scaledIdentity(size, 1), 1e-12) {
+			t.Fatalf("The product of %dx%d matrix and its inverse should be identity.", size, size)
+		}
+	}
+}
+
+func TestInverseOfSingularMutableDense(t *testing.T) {
+	matrices := []Matrix{
+		dense.New(2, 2)(
+			1, 2,
+			2, 4,
+		),
+		dense.New(4, 4)(
+			1, 2, 3, 4,
+			5, 6, 7, 8,
+			0, 0, 0, 0,
+			1, 1, 1, 1,
+		),
+		dense.Zeros(5, 5),
+	}
+
+	for _, m := range matrices {
+		if _, err := Inverse(m); err == nil || err.Error() != SingularError {
+			t.Fatalf("The singular matrix should cause %s, but %v occurred.", SingularError, err)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// Create a new matrix by applying the softmax function to each row of "m".
+// The maximum of each row is subtracted before exponentiation to avoid overflow.
+func SoftmaxRows(m Matrix) Matrix {
+	rows, columns := m.Shape()
+
+	r := dense.Zeros(rows, columns)
+
+	for row := 0; row < rows; row++ {
+		max, _, _ := m.Row(row).Max()
+
+		var sum float64
+		for column := 0; column < columns; column++ {
+			e := math.Exp(m.Get(row, column) - max)
+			r.Update(row, column, e)
+			sum += e
+		}
+
+		for column := 0; column < columns; column++ {
+			r.Update(row, column, r.Get(row, column)/sum)
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,40 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestSoftmaxRowsMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 0, 0,
+		1, 2, 3,
+	)
+
+	z := math.Exp(1) + math.Exp(2) + math.Exp(3)
+
+	r := dense.New(2, 3)(
+		1.0/3, 1.0/3, 1.0/3,
+		math.Exp(1)/z, math.Exp(2)/z, math.Exp(3)/z,
+	)
+
+	if SoftmaxRows(m).EqualApprox(r, 1e-12) {
+		return
+	}
+
+	t.Fatal("SoftmaxRows should normalize the exponentials of each row.")
+}
+
+func TestSoftmaxRowsIsStableForLargeElementsMutableDense(t *testing.T) {
+	m := dense.New(1, 2)(1000, 1000)
+
+	r := dense.New(1, 2)(0.5, 0.5)
+
+	if SoftmaxRows(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("SoftmaxRows should not overflow for large elements.")
+}
@@ -0,0 +1,67 @@
+package matrix
+
+import (
+	"sort"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+// Create a new matrix whose rows are the rows of "m" sorted by the elements in "column".
+// Rows which have the same key keep their original order.
+// When "column" is out of the columns of "m",
+// validates.OUT_OF_RANGE_PANIC will be caused.
+func SortRowsBy(m Matrix, column int, descending bool) Matrix {
+	validates.IndexShouldBeInRange(1, m.Columns(), 0, column)
+
+	rows, columns := m.Shape()
+
+	order := &rowOrder{
+		keys:       make([]float64, rows),
+		rows:       make([]int, rows),
+		descending: descending,
+	}
+
+	for row := 0; row < rows; row++ {
+		order.keys[row] = m.Get(row, column)
+		order.rows[row] = row
+	}
+
+	sort.Stable(order)
+
+	r := dense.Zeros(rows, columns)
+
+	for i, row := range order.rows {
+		for j := 0; j < columns; j++ {
+			r.Update(i, j, m.Get(row, j))
+		}
+	}
+
+	return r
+}
+
+/*
+rowOrder sorts the indexes of rows by their keys.
+*/
+type rowOrder struct {
+	keys       []float64
+	rows       []int
+	descending bool
+}
+
+func (o *rowOrder) Len() int {
+	return len(o.rows)
+}
+
+func (o *rowOrder) Less(i, j int) bool {
+	if o.descending {
+		return o.keys[i] > o.keys[j]
+	}
+
+	return o.keys[i] < o.keys[j]
+}
+
+func (o *rowOrder) Swap(i, j int) {
+	o.keys[i], o.keys[j] = o.keys[j], o.keys[i]
+	o.rows[i], o.rows[j] = o.rows[j], o.rows[i]
+}
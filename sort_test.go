@@ -0,0 +1,65 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+	"github.com/mitsuse/matrix-go/internal/validates"
+)
+
+func TestSortRowsByAscendingMutableDense(t *testing.T) {
+	m := dense.New(4, 2)(
+		0, 3,
+		1, 1,
+		2, 2,
+		3, 1,
+	)
+
+	r := dense.New(4, 2)(
+		1, 1,
+		3, 1,
+		2, 2,
+		0, 3,
+	)
+
+	if SortRowsBy(m, 1, false).Equal(r) {
+		return
+	}
+
+	t.Fatal("The rows should be sorted in ascending order keeping the order of equal keys.")
+}
+
+func TestSortRowsByDescendingMutableDense(t *testing.T) {
+	m := dense.New(4, 2)(
+		0, 3,
+		1, 1,
+		2, 2,
+		3, 1,
+	)
+
+	r := dense.New(4, 2)(
+		0, 3,
+		2, 2,
+		1, 1,
+		3, 1,
+	)
+
+	if SortRowsBy(m, 1, true).Equal(r) {
+		return
+	}
+
+	t.Fatal("The rows should be sorted in descending order keeping the order of equal keys.")
+}
+
+func TestSortRowsByCausesPanicForOutOfRangeColumnMutableDense(t *testing.T) {
+	m := dense.Zeros(2, 2)
+
+	defer func() {
+		if p := recover(); p == validates.OUT_OF_RANGE_PANIC {
+			return
+		}
+
+		t.Fatalf("The column out of range should cause %s.", validates.OUT_OF_RANGE_PANIC)
+	}()
+	SortRowsBy(m, 2, false)
+}
@@ -0,0 +1,139 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// Return the sum of all elements of "m".
+// Only non-zero elements are visited, and they are accumulated with Kahan summation.
+func Sum(m Matrix) float64 {
+	var sum, compensation float64
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, _, _ := cursor.Get()
+
+		y := element - compensation
+		t := sum + y
+		compensation = (t - sum) - y
+		sum = t
+	}
+
+	return sum
+}
+
+// Return the arithmetic mean of all elements of "m".
+func Mean(m Matrix) float64 {
+	return Sum(m) / float64(m.Rows()*m.Columns())
+}
+
+// Return the population variance of all elements of "m".
+// Non-zero elements are accumulated with Welford's algorithm,
+// and then merged with the implicit zero elements.
+func Variance(m Matrix) float64 {
+	count, mean, m2 := welford(m.NonZeros())
+
+	size := float64(m.Rows() * m.Columns())
+	zeros := size - count
+
+	// Merge the moments of non-zero elements with ones of zero elements (mean = 0, m2 = 0).
+	m2 += mean * mean * count * zeros / size
+
+	return m2 / size
+}
+
+// Return the population standard deviation of all elements of "m".
+func Std(m Matrix) float64 {
+	return math.Sqrt(Variance(m))
+}
+
+// Create a "rows x 1" column vector, the i-th element of which is the sum of the i-th row of "m".
+func SumRows(m Matrix) Matrix {
+	r := dense.Zeros(m.Rows(), 1)
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, _ := cursor.Get()
+		r.Update(row, 0, r.Get(row, 0)+element)
+	}
+
+	return r
+}
+
+// Create a "1 x columns" row vector, the j-th element of which is the sum of the j-th column of "m".
+func SumColumns(m Matrix) Matrix {
+	r := dense.Zeros(1, m.Columns())
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, _, column := cursor.Get()
+		r.Update(0, column, r.Get(0, column)+element)
+	}
+
+	return r
+}
+
+// Create a "rows x 1" column vector, the i-th element of which is the mean of the i-th row of "m".
+func MeanRows(m Matrix) Matrix {
+	return SumRows(m).Scalar(1 / float64(m.Columns()))
+}
+
+// Create a "1 x columns" row vector, the j-th element of which is the mean of the j-th column of "m".
+func MeanColumns(m Matrix) Matrix {
+	return SumColumns(m).Scalar(1 / float64(m.Rows()))
+}
+
+// Create a new matrix of running totals along each row of "m",
+// the (i, j) element of which is the sum of the i-th row of "m" up to the j-th column.
+func CumSumRows(m Matrix) Matrix {
+	rows, columns := m.Shape()
+
+	r := dense.Zeros(rows, columns)
+
+	for row := 0; row < rows; row++ {
+		var sum float64
+		for column := 0; column < columns; column++ {
+			sum += m.Get(row, column)
+			r.Update(row, column, sum)
+		}
+	}
+
+	return r
+}
+
+// Create a new matrix of running totals along each column of "m",
+// the (i, j) element of which is the sum of the j-th column of "m" up to the i-th row.
+func CumSumColumns(m Matrix) Matrix {
+	rows, columns := m.Shape()
+
+	r := dense.Zeros(rows, columns)
+
+	for column := 0; column < columns; column++ {
+		var sum float64
+		for row := 0; row < rows; row++ {
+			sum += m.Get(row, column)
+			r.Update(row, column, sum)
+		}
+	}
+
+	return r
+}
+
+// Compute the count, mean and sum of squared deviations of elements visited by "cursor".
+func welford(cursor Cursor) (count, mean, m2 float64) {
+	for cursor.HasNext() {
+		element, _, _ := cursor.Get()
+
+		count++
+		delta := element - mean
+		mean += delta / count
+		m2 += delta * (element - mean)
+	}
+
+	return count, mean, m2
+}
@@ -0,0 +1,246 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestSumMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 1, 2,
+		3, 0, -4,
+	)
+
+	s := Sum(m)
+
+	if s == 2 {
+		return
+	}
+
+	t.Fatalf("The sum should be %v, but is %v.", 2, s)
+}
+
+func TestSumIsCompensatedMutableDense(t *testing.T) {
+	elements := make([]float64, 10001)
+	elements[0] = 1
+	for i := 1; i < len(elements); i++ {
+		elements[i] = 1e-16
+	}
+
+	m := dense.New(1, len(elements))(elements...)
+
+	s := Sum(m)
+
+	if math.Abs(s-(1+1e-12)) < 1e-20 {
+		return
+	}
+
+	t.Fatalf("The sum should be %v, but is %v.", 1+1e-12, s)
+}
+
+func TestMeanMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		0, 1,
+		2, 5,
+	)
+
+	mean := Mean(m)
+
+	if mean == 2 {
+		return
+	}
+
+	t.Fatalf("The mean should be %v, but is %v.", 2, mean)
+}
+
+func TestVarianceMutableDense(t *testing.T) {
+	m := dense.New(2, 4)(
+		2, 4, 4, 4,
+		5, 5, 7, 9,
+	)
+
+	v := Variance(m)
+
+	if math.Abs(v-4) < 1e-12 {
+		return
+	}
+
+	t.Fatalf("The variance should be %v, but is %v.", 4, v)
+}
+
+func TestVarianceIncludesZerosMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		0, 2,
+		0, 2,
+	)
+
+	v := Variance(m)
+
+	if math.Abs(v-1) < 1e-12 {
+		return
+	}
+
+	t.Fatalf("The variance should be %v, but is %v.", 1, v)
+}
+
+func TestVarianceOfZerosMutableDense(t *testing.T) {
+	m := dense.Zeros(3, 3)
+
+	v := Variance(m)
+
+	if v == 0 {
+		return
+	}
+
+	t.Fatalf("The variance should be %v, but is %v.", 0, v)
+}
+
+func TestStdMutableDense(t *testing.T) {
+	m := dense.New(2, 4)(
+		2, 4, 4, 4,
+		5, 5, 7, 9,
+	)
+
+	s := Std(m)
+
+	if math.Abs(s-2) < 1e-12 {
+		return
+	}
+
+	t.Fatalf("The standard deviation should be %v, but is %v.", 2, s)
+}
+
+func TestSumRowsMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 1, 2,
+		3, 0, -4,
+	)
+
+	r := dense.New(2, 1)(
+		3,
+		-1,
+	)
+
+	if SumRows(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("SumRows should return the column vector of sums of each row.")
+}
+
+func TestSumColumnsMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 1, 2,
+		3, 0, -4,
+	)
+
+	r := dense.New(1, 3)(3, 1, -2)
+
+	if SumColumns(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("SumColumns should return the row vector of sums of each column.")
+}
+
+func TestSumColumnsOfTransposeMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		0, 1, 2,
+		3, 0, -4,
+	).Transpose()
+
+	r := dense.New(1, 2)(3, -1)
+
+	if SumColumns(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("SumColumns should respect the transposed indexes.")
+}
+
+func TestMeanRowsMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 3,
+		2, 0,
+	)
+
+	r := dense.New(2, 1)(
+		2,
+		1,
+	)
+
+	if MeanRows(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("MeanRows should return the column vector of means of each row.")
+}
+
+func TestMeanColumnsMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 3,
+		3, 0,
+	)
+
+	r := dense.New(1, 2)(2, 1.5)
+
+	if MeanColumns(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("MeanColumns should return the row vector of means of each column.")
+}
+
+func TestCumSumRowsMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		4, 0, -4,
+	)
+
+	r := dense.New(2, 3)(
+		1, 3, 6,
+		4, 4, 0,
+	)
+
+	if CumSumRows(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("CumSumRows should return the running totals along each row.")
+}
+
+func TestCumSumColumnsMutableDense(t *testing.T) {
+	m := dense.New(3, 2)(
+		1, 4,
+		2, 0,
+		3, -4,
+	)
+
+	r := dense.New(3, 2)(
+		1, 4,
+		3, 4,
+		6, 0,
+	)
+
+	if CumSumColumns(m).Equal(r) {
+		return
+	}
+
+	t.Fatal("CumSumColumns should return the running totals along each column.")
+}
+
+func TestCumSumRowsOfTransposeEqualsTransposeOfCumSumColumnsMutableDense(t *testing.T) {
+	m := dense.New(3, 2)(
+		1, 4,
+		2, 0,
+		3, -4,
+	)
+
+	if CumSumRows(m.Transpose()).Equal(CumSumColumns(m).Transpose()) {
+		return
+	}
+
+	t.Fatal("CumSumRows of the transpose should equal to the transpose of CumSumColumns.")
+}
@@ -0,0 +1,43 @@
+package matrix
+
+import (
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// Create a new matrix which consists of the elements on and above the "k"-th diagonal of "m".
+// "k" = 0 is the main diagonal, "k" > 0 is above it, and "k" < 0 is below it.
+// Only non-zero elements of "m" are visited.
+func Triu(m Matrix, k int) Matrix {
+	match := func(element float64, row, column int) bool {
+		return column-row >= k
+	}
+
+	return extractTriangle(m, match)
+}
+
+// Create a new matrix which consists of the elements on and below the "k"-th diagonal of "m".
+// "k" = 0 is the main diagonal, "k" > 0 is above it, and "k" < 0 is below it.
+// Only non-zero elements of "m" are visited.
+func Tril(m Matrix, k int) Matrix {
+	match := func(element float64, row, column int) bool {
+		return column-row <= k
+	}
+
+	return extractTriangle(m, match)
+}
+
+// Copy non-zero elements of "m" satisfying "match" into a new zero matrix.
+func extractTriangle(m Matrix, match matchFunc) Matrix {
+	r := dense.Zeros(m.Rows(), m.Columns())
+
+	cursor := m.NonZeros()
+
+	for cursor.HasNext() {
+		element, row, column := cursor.Get()
+		if match(element, row, column) {
+			r.Update(row, column, element)
+		}
+	}
+
+	return r
+}
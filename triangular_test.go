@@ -0,0 +1,133 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+type triangleTest struct {
+	k      int
+	result Matrix
+}
+
+func TestTriuMutableDense(t *testing.T) {
+	m := dense.New(3, 4)(
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 1, 2, 3,
+	)
+
+	tests := []*triangleTest{
+		&triangleTest{
+			k: 0,
+			result: dense.New(3, 4)(
+				1, 2, 3, 4,
+				0, 6, 7, 8,
+				0, 0, 2, 3,
+			),
+		},
+		&triangleTest{
+			k: 2,
+			result: dense.New(3, 4)(
+				0, 0, 3, 4,
+				0, 0, 0, 8,
+				0, 0, 0, 0,
+			),
+		},
+		&triangleTest{
+			k: -1,
+			result: dense.New(3, 4)(
+				1, 2, 3, 4,
+				5, 6, 7, 8,
+				0, 1, 2, 3,
+			),
+		},
+	}
+
+	for _, test := range tests {
+		if r := Triu(m, test.k); !r.Equal(test.result) {
+			t.Fatalf("The upper triangular part with k = %d is wrong.", test.k)
+		}
+	}
+}
+
+func TestTrilMutableDense(t *testing.T) {
+	m := dense.New(3, 4)(
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 1, 2, 3,
+	)
+
+	tests := []*triangleTest{
+		&triangleTest{
+			k: 0,
+			result: dense.New(3, 4)(
+				1, 0, 0, 0,
+				5, 6, 0, 0,
+				9, 1, 2, 0,
+			),
+		},
+		&triangleTest{
+			k: 1,
+			result: dense.New(3, 4)(
+				1, 2, 0, 0,
+				5, 6, 7, 0,
+				9, 1, 2, 3,
+			),
+		},
+		&triangleTest{
+			k: -2,
+			result: dense.New(3, 4)(
+				0, 0, 0, 0,
+				0, 0, 0, 0,
+				9, 0, 0, 0,
+			),
+		},
+	}
+
+	for _, test := range tests {
+		if r := Tril(m, test.k); !r.Equal(test.result) {
+			t.Fatalf("The lower triangular part with k = %d is wrong.", test.k)
+		}
+	}
+}
+
+func TestTriuDoesNotRewriteTheOriginalMutableDense(t *testing.T) {
+	m := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	n := dense.New(2, 2)(
+		1, 2,
+		3, 4,
+	)
+
+	Triu(m, 0)
+
+	if m.Equal(n) {
+		return
+	}
+
+	t.Fatal("Triu should create a new matrix instead of rewriting the original.")
+}
+
+func TestTrilOfTransposeMutableDense(t *testing.T) {
+	m := dense.New(2, 3)(
+		1, 2, 3,
+		4, 5, 6,
+	).Transpose()
+
+	r := dense.New(3, 2)(
+		1, 0,
+		2, 5,
+		3, 6,
+	)
+
+	if Tril(m, 0).Equal(r) {
+		return
+	}
+
+	t.Fatal("Tril should respect the transposed indexes.")
+}
@@ -0,0 +1,204 @@
+/*
+Package "tune" chooses the parameters of dense multiplication by measuring them on the running machine.
+The chosen parameters are cached in a file per machine, so the measurement runs only on the first use.
+*/
+package tune
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+/*
+"Parameters" are the parameters of dense multiplication chosen for a machine.
+For more details, refer "dense.SetBlockSize" and "dense.SetStrassenThreshold".
+*/
+type Parameters struct {
+	Machine           string `json:"machine"`
+	BlockSize         int    `json:"block_size"`
+	StrassenThreshold int    `json:"strassen_threshold"`
+}
+
+// Apply the parameters to the dense package.
+func (p *Parameters) Apply() {
+	dense.SetBlockSize(p.BlockSize)
+	dense.SetStrassenThreshold(p.StrassenThreshold)
+}
+
+/*
+"Tuner" measures the candidates of the parameters.
+Use "NewTuner" to create it with the default candidates.
+*/
+type Tuner struct {
+	// The candidates of the block size, measured with matrices of "BlockSizeProblem" x "BlockSizeProblem".
+	BlockSizes       []int
+	BlockSizeProblem int
+
+	// The candidates of the Strassen threshold, measured with matrices of "StrassenProblem" x "StrassenProblem".
+	// The non-positive threshold disables the Strassen algorithm.
+	// When it is empty, the current threshold is kept.
+	// The Strassen algorithm rounds differently from the blocked one,
+	// and the chosen threshold applies to all dense products, so it is empty unless the caller sets it.
+	StrassenThresholds []int
+	StrassenProblem    int
+
+	// The number of measurements of each candidate, the fastest of which is compared.
+	Repeats int
+
+	// The file where the parameters are cached.
+	// When it is empty, the parameters are not cached.
+	CachePath string
+}
+
+// Create a new tuner with the default candidates,
+// which caches the parameters in "matrix-go/tune.json" under the cache directory of the user.
+// It measures only the block size and keeps the Strassen threshold.
+func NewTuner() *Tuner {
+	t := &Tuner{
+		BlockSizes:         []int{16, 32, 64, 128, 256},
+		BlockSizeProblem:   512,
+		StrassenThresholds: nil,
+		StrassenProblem:    1024,
+		Repeats:            3,
+		CachePath:          defaultCachePath(),
+	}
+
+	return t
+}
+
+// Measure the candidates with "NewTuner", apply the fastest ones, and cache them.
+func Run() (*Parameters, error) {
+	return NewTuner().Run()
+}
+
+// Apply the cached parameters of the running machine with "NewTuner",
+// or run the measurement when they are not cached.
+func Ensure() (*Parameters, error) {
+	return NewTuner().Ensure()
+}
+
+// Measure the candidates, apply the fastest ones, and cache them.
+// The parameters are applied even when they cannot be cached, and the error is returned.
+func (t *Tuner) Run() (*Parameters, error) {
+	p := &Parameters{
+		Machine:           Machine(),
+		BlockSize:         dense.BlockSize(),
+		StrassenThreshold: dense.StrassenThreshold(),
+	}
+
+	defer p.Apply()
+
+	if len(t.BlockSizes) > 0 {
+		p.BlockSize = fastest(t.BlockSizes, t.Repeats, t.BlockSizeProblem, func(size int) {
+			dense.SetBlockSize(size)
+			dense.SetStrassenThreshold(0)
+		})
+	}
+
+	if len(t.StrassenThresholds) > 0 {
+		p.StrassenThreshold = fastest(t.StrassenThresholds, t.Repeats, t.StrassenProblem, func(threshold int) {
+			dense.SetBlockSize(p.BlockSize)
+			dense.SetStrassenThreshold(threshold)
+		})
+	}
+
+	if t.CachePath == "" {
+		return p, nil
+	}
+
+	return p, save(t.CachePath, p)
+}
+
+// Apply the cached parameters of the running machine,
+// or run the measurement when they are not cached or are cached for another machine.
+func (t *Tuner) Ensure() (*Parameters, error) {
+	if t.CachePath != "" {
+		if p, err := load(t.CachePath); err == nil && p.Machine == Machine() && p.BlockSize > 0 {
+			p.Apply()
+
+			return p, nil
+		}
+	}
+
+	return t.Run()
+}
+
+// Return the name of the running machine, which are the OS, the architecture and the number of CPUs.
+func Machine() string {
+	return fmt.Sprintf("%s/%s/%d", runtime.GOOS, runtime.GOARCH, runtime.NumCPU())
+}
+
+// Return the candidate for which "configure" makes the multiplication of "size" x "size" matrices fastest.
+func fastest(candidates []int, repeats, size int, configure func(candidate int)) int {
+	m := dense.Zeros(size, size)
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			m.Update(i, j, float64((i+j)%7))
+		}
+	}
+
+	best, bestTime := candidates[0], time.Duration(-1)
+
+	for _, candidate := range candidates {
+		configure(candidate)
+
+		for repeat := 0; repeat < repeats || repeat == 0; repeat++ {
+			begin := time.Now()
+			m.Multiply(m)
+
+			if elapsed := time.Since(begin); bestTime < 0 || elapsed < bestTime {
+				best, bestTime = candidate, elapsed
+			}
+		}
+	}
+
+	return best
+}
+
+func defaultCachePath() string {
+	directory := os.Getenv("XDG_CACHE_HOME")
+	if directory == "" {
+		home := os.Getenv("HOME")
+		if home == "" {
+			return ""
+		}
+
+		directory = filepath.Join(home, ".cache")
+	}
+
+	return filepath.Join(directory, "matrix-go", "tune.json")
+}
+
+func load(path string) (*Parameters, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Parameters{}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func save(path string, p *Parameters) error {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b, 0644)
+}
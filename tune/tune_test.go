@@ -0,0 +1,132 @@
+package tune
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func restore() func() {
+	size, threshold := dense.BlockSize(), dense.StrassenThreshold()
+
+	return func() {
+		dense.SetBlockSize(size)
+		dense.SetStrassenThreshold(threshold)
+	}
+}
+
+func newTestTuner(t *testing.T) (*Tuner, func()) {
+	directory, err := ioutil.TempDir("", "tune")
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	tuner := &Tuner{
+		BlockSizes:         []int{4, 8},
+		BlockSizeProblem:   16,
+		StrassenThresholds: []int{0, 8},
+		StrassenProblem:    16,
+		Repeats:            1,
+		CachePath:          filepath.Join(directory, "matrix-go", "tune.json"),
+	}
+
+	return tuner, func() { os.RemoveAll(directory) }
+}
+
+func TestRunAppliesAndCachesParameters(t *testing.T) {
+	defer restore()()
+
+	tuner, clean := newTestTuner(t)
+	defer clean()
+
+	p, err := tuner.Run()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if p.Machine != Machine() || (p.BlockSize != 4 && p.BlockSize != 8) || (p.StrassenThreshold != 0 && p.StrassenThreshold != 8) {
+		t.Fatalf("The parameters should be chosen from the candidates, but are %+v.", p)
+	}
+
+	if dense.BlockSize() != p.BlockSize || dense.StrassenThreshold() != p.StrassenThreshold {
+		t.Fatal("The chosen parameters should be applied.")
+	}
+
+	cached, err := load(tuner.CachePath)
+	if err != nil || *cached != *p {
+		t.Fatalf("The parameters should be cached, but %+v is cached with %v.", cached, err)
+	}
+}
+
+func TestEnsureAppliesCachedParameters(t *testing.T) {
+	defer restore()()
+
+	tuner, clean := newTestTuner(t)
+	defer clean()
+
+	// The candidates are not measured when the cached parameters are found.
+	tuner.BlockSizes = nil
+	tuner.StrassenThresholds = nil
+
+	if err := save(tuner.CachePath, &Parameters{Machine: Machine(), BlockSize: 3, StrassenThreshold: 5}); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if _, err := tuner.Ensure(); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if dense.BlockSize() == 3 && dense.StrassenThreshold() == 5 {
+		return
+	}
+
+	t.Fatal("The cached parameters should be applied.")
+}
+
+func TestEnsureRunsForAnotherMachine(t *testing.T) {
+	defer restore()()
+
+	tuner, clean := newTestTuner(t)
+	defer clean()
+
+	if err := save(tuner.CachePath, &Parameters{Machine: "other", BlockSize: 3, StrassenThreshold: 5}); err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	p, err := tuner.Ensure()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if p.Machine == Machine() && p.BlockSize != 3 {
+		return
+	}
+
+	t.Fatal("The parameters cached for another machine should be measured again.")
+}
+
+func TestNewTunerKeepsStrassenThreshold(t *testing.T) {
+	defer restore()()
+
+	tuner := NewTuner()
+	tuner.BlockSizes = []int{4}
+	tuner.BlockSizeProblem = 16
+	tuner.Repeats = 1
+	tuner.CachePath = ""
+
+	dense.SetStrassenThreshold(3)
+
+	p, err := tuner.Run()
+	if err != nil {
+		t.Fatalf("An unexpected error %q occurred.", err)
+	}
+
+	if p.StrassenThreshold == 3 && dense.StrassenThreshold() == 3 {
+		return
+	}
+
+	t.Fatalf("The Strassen threshold should be kept, but is %d.", dense.StrassenThreshold())
+}
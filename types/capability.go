@@ -0,0 +1,32 @@
+package types
+
+/*
+"Capability" is a set of flags declaring what an implementation of "Matrix" supports,
+so that generic algorithms can choose the best strategy without type switches.
+*/
+type Capability uint
+
+const (
+	// The elements can be updated in place, and mutating operations return the receiver.
+	Mutable Capability = 1 << iota
+
+	// Only non-zero elements are stored, so "NonZeros" is cheaper than "All".
+	Sparse
+
+	// The elements occupy a contiguous range of the backing storage.
+	Contiguous
+
+	// The matrix can be read and updated from multiple goroutines without synchronization.
+	ThreadSafe
+
+	// "View" shares the backing storage instead of copying elements.
+	Views
+
+	// The rows and the columns are swapped in the backing storage, that is, the elements are laid out column by column.
+	Transposed
+)
+
+// Check whether all of "flags" are set.
+func (c Capability) Has(flags Capability) bool {
+	return c&flags == flags
+}
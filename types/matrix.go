@@ -8,6 +8,9 @@ type Matrix interface {
 	// Serialize the receiver matrix by using the given writer.
 	Serialize(wrtier io.Writer) error
 
+	// Return the capabilities of the receiver.
+	Capabilities() Capability
+
 	// Return the shape of matrix, which consists of the "rows" and the "columns".
 	Shape() (rows, columns int)
 
@@ -21,11 +24,38 @@ type Matrix interface {
 	All() Cursor
 
 	// Create and return an iterator for non-zero elements.
+	// Elements whose absolute values are not greater than the zero threshold are skipped.
 	NonZeros() Cursor
 
 	// Create and return an iterator for diagonal elements.
 	Diagonal() Cursor
 
+	// Create and return an iterator for anti-diagonal elements,
+	// which starts from the top-right element towards the bottom-left.
+	AntiDiagonal() Cursor
+
+	// Create and return an iterator for elements on the "offset"-th diagonal.
+	// "offset" = 0 is the main diagonal, "offset" > 0 is above it, and "offset" < 0 is below it.
+	Diagonals(offset int) Cursor
+
+	// Return the number of non-zero elements.
+	// Elements whose absolute values are not greater than the zero threshold are not counted.
+	NonZeroCount() int
+
+	// Return the ratio of non-zero elements to all elements.
+	Density() float64
+
+	// Return the threshold, at or below which absolute values are treated as zero.
+	// The threshold of a new matrix is 0.
+	ZeroThreshold() float64
+
+	// Set the zero threshold, which is shared with views created afterwards.
+	// The threshold is used only by "NonZeros", "NonZeroCount" and the functions built on them,
+	// and arithmetic always uses the elements as they are.
+	// The threshold is kept by serialization since format version 1.
+	// When "epsilon" is negative or NaN, validates.INVALID_THRESHOLD_PANIC will be caused.
+	SetZeroThreshold(epsilon float64) Matrix
+
 	// Get an element of matrix specified with "row" and "column".
 	// When "row" or "column" is lower than the number of rows or columns,
 	// validates.OUT_OF_RANGE_PANIC will be caused.
@@ -41,6 +71,18 @@ type Matrix interface {
 	// validates.DIFFERENT_SIZE_PANIC will be caused.
 	Equal(n Matrix) bool
 
+	// Check element-wise equality with the absolute tolerance "epsilon",
+	// that is, |m - n| <= epsilon for every element.
+	// When the shape of the receiver and the argument is different,
+	// validates.DIFFERENT_SIZE_PANIC will be caused.
+	EqualApprox(n Matrix, epsilon float64) bool
+
+	// Check element-wise equality with the relative tolerance "epsilon",
+	// that is, |m - n| <= epsilon * max(|m|, |n|) for every element.
+	// When the shape of the receiver and the argument is different,
+	// validates.DIFFERENT_SIZE_PANIC will be caused.
+	EqualApproxRelative(n Matrix, epsilon float64) bool
+
 	// Add the given matrix to the receiver matrix.
 	// When the shape of the receiver and the argument is different,
 	// validates.DIFFERENT_SIZE_PANIC will be caused.
@@ -60,6 +102,14 @@ type Matrix interface {
 	// Multiply by scalar value.
 	Scalar(s float64) Matrix
 
+	// Replace the elements at which "mask" is non-zero with "value".
+	// When the shape of the receiver and the mask is different,
+	// validates.DIFFERENT_SIZE_PANIC will be caused.
+	SetWhere(mask Matrix, value float64) Matrix
+
+	// Bound every element to the range between "min" and "max".
+	Clip(min, max float64) Matrix
+
 	// Create the transpose matrix.
 	Transpose() Matrix
 
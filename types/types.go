@@ -0,0 +1,6 @@
+/*
+Package "types" provides the interfaces which represent matrix and iterator of elements,
+and the capabilities of implementations.
+Implementations of matrix types, including those outside of this module, implement "Matrix" of this package.
+*/
+package types
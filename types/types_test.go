@@ -0,0 +1,15 @@
+package types
+
+import (
+	"testing"
+)
+
+func TestCapabilityHasFlags(t *testing.T) {
+	c := Mutable | Views
+
+	if c.Has(Mutable) && c.Has(Mutable|Views) && !c.Has(Sparse) && !c.Has(Mutable|Contiguous) {
+		return
+	}
+
+	t.Fatal("Capability should have only the set flags.")
+}
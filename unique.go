@@ -0,0 +1,79 @@
+package matrix
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+// Create a new matrix which consists of the distinct rows of "m" in order of first appearance.
+// The returned "indexes" maps each row of "m" to the corresponding row of the result.
+// Rows are bucketed by their hash, so only rows in the same bucket are compared.
+func UniqueRows(m Matrix) (unique Matrix, indexes []int) {
+	rows, columns := m.Shape()
+
+	buckets := make(map[uint64][]int)
+	uniques := []int{}
+	indexes = make([]int, rows)
+
+	for row := 0; row < rows; row++ {
+		h := hashRow(m, row)
+
+		found := -1
+		for _, u := range buckets[h] {
+			if equalRows(m, uniques[u], row) {
+				found = u
+				break
+			}
+		}
+
+		if found < 0 {
+			found = len(uniques)
+			uniques = append(uniques, row)
+			buckets[h] = append(buckets[h], found)
+		}
+
+		indexes[row] = found
+	}
+
+	r := dense.Zeros(len(uniques), columns)
+
+	for i, row := range uniques {
+		for column := 0; column < columns; column++ {
+			r.Update(i, column, m.Get(row, column))
+		}
+	}
+
+	return r, indexes
+}
+
+func hashRow(m Matrix, row int) uint64 {
+	h := fnv.New64a()
+	b := make([]byte, 8)
+
+	for column := 0; column < m.Columns(); column++ {
+		element := m.Get(row, column)
+
+		// Positive and negative zeros are equal, so they should share the hash.
+		if element == 0 {
+			element = 0
+		}
+
+		binary.LittleEndian.PutUint64(b, math.Float64bits(element))
+		h.Write(b)
+	}
+
+	return h.Sum64()
+}
+
+func equalRows(m Matrix, i, j int) bool {
+	for column := 0; column < m.Columns(); column++ {
+		if m.Get(i, column) != m.Get(j, column) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,51 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestUniqueRowsMutableDense(t *testing.T) {
+	m := dense.New(5, 2)(
+		1, 2,
+		3, 4,
+		1, 2,
+		0, 0,
+		3, 4,
+	)
+
+	r := dense.New(3, 2)(
+		1, 2,
+		3, 4,
+		0, 0,
+	)
+
+	indexes := []int{0, 1, 0, 2, 1}
+
+	unique, mapping := UniqueRows(m)
+
+	if !unique.Equal(r) {
+		t.Fatal("UniqueRows should return the distinct rows in order of first appearance.")
+	}
+
+	for i, index := range indexes {
+		if mapping[i] != index {
+			t.Fatalf("The %d-th row should be mapped to %d, but is mapped to %d.", i, index, mapping[i])
+		}
+	}
+}
+
+func TestUniqueRowsTreatsSignedZerosAsEqualMutableDense(t *testing.T) {
+	m := dense.New(2, 1)(
+		0,
+		math.Copysign(0, -1),
+	)
+
+	if unique, _ := UniqueRows(m); unique.Rows() == 1 {
+		return
+	}
+
+	t.Fatal("The positive and negative zeros should be equal.")
+}
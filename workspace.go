@@ -0,0 +1,17 @@
+package matrix
+
+import (
+	"github.com/mitsuse/matrix-go/internal/workspace"
+)
+
+// Call "f" with the workspace enabled, and return the value returned by "f".
+// While the workspace is enabled, temporaries of "Multiply" of dense matrices,
+// decompositions and solvers are taken from a pool and returned to it after use,
+// so repeated operations on matrices of the same size don't allocate them every time.
+// The workspace is shared by all goroutines, and stays enabled while any call of "WithWorkspace" runs.
+func WithWorkspace(f func() error) error {
+	workspace.Enable()
+	defer workspace.Disable()
+
+	return f()
+}
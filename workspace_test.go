@@ -0,0 +1,53 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mitsuse/matrix-go/decompose"
+	"github.com/mitsuse/matrix-go/dense"
+)
+
+func TestWithWorkspaceKeepsResults(t *testing.T) {
+	m := dense.New(3, 2)(
+		1, 2,
+		3, 4,
+		5, 7,
+	)
+	n := dense.New(2, 3)(
+		1, 0, 2,
+		0, 1, 3,
+	)
+	b := dense.New(3, 1)(1, 2, 3)
+
+	product := m.Multiply(n)
+	solution := decompose.SVD(m).Solve(b)
+
+	err := WithWorkspace(func() error {
+		for i := 0; i < 3; i++ {
+			if !m.Multiply(n).Equal(product) || !decompose.SVD(m).Solve(b).Equal(solution) {
+				return errors.New("wrong result")
+			}
+		}
+
+		return nil
+	})
+
+	if err == nil {
+		return
+	}
+
+	t.Fatal("The results with the workspace should be same as the ones without it.")
+}
+
+func TestWithWorkspaceReturnsError(t *testing.T) {
+	err := WithWorkspace(func() error {
+		return errors.New("error")
+	})
+
+	if err != nil && err.Error() == "error" {
+		return
+	}
+
+	t.Fatal("The error returned by the function should be returned.")
+}